@@ -0,0 +1,65 @@
+package deploy
+
+import (
+	"cli/pkg/config"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+)
+
+func NewAbortCmd() *cobra.Command {
+	var opts targetOptions
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "abort",
+		Short: "Abort a canary rollout",
+		Long: `Abort the canary rollout of an environment. All traffic is routed back to
+the stable version and the canary is removed.
+
+Examples:
+  portway deploy abort
+  portway deploy abort --env staging --yes
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := config.ResolveTarget(opts.configPath, opts.projectName, opts.envName)
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed := false
+				form := huh.NewForm(
+					huh.NewGroup(
+						huh.NewConfirm().
+							Title(fmt.Sprintf("Abort the canary rollout in %s?", t.EnvName)).
+							Value(&confirmed),
+					),
+				)
+				if err := form.Run(); err != nil {
+					return fmt.Errorf("failed to run prompt: %w", err)
+				}
+				if !confirmed {
+					return nil
+				}
+			}
+
+			rollout, err := abortCanary(t.Client, t.OrgSlug, t.ProjectSlug, t.EnvName)
+			if err != nil {
+				return err
+			}
+
+			printCanaryAborted(rollout, "")
+
+			return nil
+		},
+	}
+
+	addTargetFlags(cmd, &opts)
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation")
+
+	return cmd
+}
@@ -0,0 +1,329 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/probe"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/pterm/pterm"
+)
+
+const (
+	strategyAll    = "all"
+	strategyCanary = "canary"
+)
+
+// canaryCheckInterval is how often the canary health is polled during a pause
+const canaryCheckInterval = 10 * time.Second
+
+// canaryProbeFailureThreshold is how many readiness probes in a row must fail
+// before a canary is aborted
+const canaryProbeFailureThreshold = 3
+
+// deployRequest builds the deploy request body for the selected strategy
+func deployRequest(opts deployOptions) api.DeployEnvironmentComposeFileJSONRequestBody {
+	strategy := api.DeployRequestStrategy(opts.strategy)
+	body := api.DeployEnvironmentComposeFileJSONRequestBody{Strategy: &strategy}
+	if opts.strategy == strategyCanary {
+		body.Steps = &opts.steps
+	}
+	return body
+}
+
+// validateStrategy checks the --strategy and --steps flags
+func validateStrategy(opts deployOptions) error {
+	switch opts.strategy {
+	case strategyAll:
+		return nil
+	case strategyCanary:
+	default:
+		return fmt.Errorf("invalid strategy %q, expected %s or %s", opts.strategy, strategyAll, strategyCanary)
+	}
+
+	if len(opts.steps) == 0 {
+		return fmt.Errorf("--steps must list at least one step")
+	}
+
+	previous := 0
+	for _, step := range opts.steps {
+		if step <= previous || step > 100 {
+			return fmt.Errorf("invalid --steps %s: steps must increase and be between 1 and 100", formatSteps(opts.steps))
+		}
+		previous = step
+	}
+
+	if previous != 100 {
+		return fmt.Errorf("invalid --steps %s: the last step must be 100", formatSteps(opts.steps))
+	}
+
+	return nil
+}
+
+func formatSteps(steps []int) string {
+	parts := make([]string, 0, len(steps))
+	for _, step := range steps {
+		parts = append(parts, fmt.Sprintf("%d", step))
+	}
+	return strings.Join(parts, ",")
+}
+
+// canaryRollout drives a canary deployment through its steps, gating each
+// step on the health of the canary
+type canaryRollout struct {
+	client         *api.ClientWithResponses
+	orgSlug        string
+	projectSlug    string
+	envName        string
+	version        string
+	steps          []int
+	pause          time.Duration
+	minHealthScore float32
+	readiness      *probe.Options
+	deployURL      string
+	timeout        time.Duration
+}
+
+// canaryHealth is a snapshot of the health of the canary deployments
+type canaryHealth struct {
+	score    float32
+	restarts int
+}
+
+// run promotes the canary step by step once the first step is deployed. It
+// returns true when the rollout was left paused for a manual promote.
+func (c *canaryRollout) run(ids []uuid.UUID) (bool, error) {
+	for i, weight := range c.steps {
+		if i > 0 {
+			rollout, err := promoteCanary(c.client, c.orgSlug, c.projectSlug, c.envName, &weight)
+			if err != nil {
+				return false, err
+			}
+			ids = []uuid.UUID{rollout.DeploymentId}
+
+			spinner, done := startDeploySpinner(fmt.Sprintf("Routing %d%% of traffic to the canary", weight))
+			failed, err := waitForDeployments(c.client, spinner, done, ids, c.timeout)
+			if err != nil {
+				return false, err
+			}
+			if failed != nil {
+				ExitSpinner(spinner, color.RedString("Canary step failed."))
+				fmt.Println()
+				printHealthOrError(c.client, failed.ID)
+				return false, c.abort(fmt.Sprintf("deployment %s", failed.State))
+			}
+			ExitSpinner(spinner, "Canary step deployed.")
+		}
+
+		pterm.Printf("🐤 Canary %s receives %s of traffic\n", pterm.Cyan(c.version), pterm.Bold.Sprintf("%d%%", weight))
+		fmt.Println()
+
+		if weight == 100 {
+			return false, nil
+		}
+
+		if c.pause == 0 {
+			printCanaryPaused(weight)
+			return true, nil
+		}
+
+		reason, err := c.watch(ids, weight)
+		if err != nil {
+			return false, err
+		}
+		if reason != "" {
+			return false, c.abort(reason)
+		}
+	}
+
+	return false, nil
+}
+
+// watch polls the canary for the pause duration. It returns why the canary
+// should be aborted, or an empty reason when it stayed healthy.
+func (c *canaryRollout) watch(ids []uuid.UUID, weight int) (string, error) {
+	baseline, err := c.health(ids)
+	if err != nil {
+		return "", err
+	}
+
+	spinner, done := startDeploySpinner(fmt.Sprintf("Watching the canary at %d%% for %s", weight, c.pause))
+	deadline := time.Now().Add(c.pause)
+	probeFailures := 0
+
+	for {
+		current, err := c.health(ids)
+		if err != nil {
+			ExitSpinner(spinner, color.RedString("Failed to check canary health."))
+			return "", err
+		}
+
+		spinner.Send(logMsg{
+			timestamp: time.Now(),
+			log:       fmt.Sprintf("Health score %.0f, %d restarts", current.score, current.restarts),
+			stream:    "stdout",
+		})
+
+		reason := ""
+		switch {
+		case current.score < c.minHealthScore:
+			reason = fmt.Sprintf("health score %.0f is below %.0f", current.score, c.minHealthScore)
+		case current.restarts > baseline.restarts:
+			reason = fmt.Sprintf("pods restarted %d times", current.restarts-baseline.restarts)
+		}
+
+		if reason == "" && c.readiness != nil {
+			result := probe.Check(context.Background(), c.deployURL, c.readiness)
+			if result.Ready(c.readiness) {
+				probeFailures = 0
+			} else {
+				probeFailures++
+				if probeFailures >= canaryProbeFailureThreshold {
+					reason = "readiness probe failed: " + result.Reason(c.readiness)
+				}
+			}
+		}
+
+		if reason != "" {
+			ExitSpinner(spinner, color.RedString("Canary is unhealthy: %s", reason))
+			fmt.Println()
+			return reason, nil
+		}
+
+		if time.Now().After(deadline) {
+			ExitSpinner(spinner, "Canary is healthy.")
+			fmt.Println()
+			return "", nil
+		}
+
+		// Wait for the next check, stopping as soon as the spinner is interrupted
+		select {
+		case err := <-done:
+			if err != nil {
+				fmt.Println()
+				fmt.Println(color.RedString("Canary watch interrupted. The rollout stays at %d%%.", weight))
+				fmt.Printf("Continue with %s or roll back with %s\n", color.CyanString("portway deploy promote"), color.CyanString("portway deploy abort"))
+				fmt.Println()
+				return "", err
+			}
+		case <-time.After(canaryCheckInterval):
+		}
+	}
+}
+
+// health returns the lowest health score and the total pod restarts of the
+// canary deployments
+func (c *canaryRollout) health(ids []uuid.UUID) (canaryHealth, error) {
+	snapshot := canaryHealth{score: 100}
+
+	for _, id := range ids {
+		health, err := c.client.GetDeploymentHealthWithResponse(context.Background(), id)
+		if err != nil {
+			return snapshot, fmt.Errorf("failed to get deployment health: %w", err)
+		}
+		if health.StatusCode() != http.StatusOK || health.JSON200 == nil {
+			return snapshot, fmt.Errorf("failed to get deployment health: %s", health.Status())
+		}
+
+		info := health.JSON200
+		if info.HealthScore < snapshot.score {
+			snapshot.score = info.HealthScore
+		}
+		if info.Health != nil && info.Health.Pods != nil {
+			for _, pod := range *info.Health.Pods {
+				if pod.Restarts != nil {
+					snapshot.restarts += int(*pod.Restarts)
+				}
+			}
+		}
+	}
+
+	return snapshot, nil
+}
+
+// abort routes all traffic back to the stable version and returns an
+// exitError with exitCodeRolledBack
+func (c *canaryRollout) abort(reason string) error {
+	rollout, err := abortCanary(c.client, c.orgSlug, c.projectSlug, c.envName)
+	if err != nil {
+		return fmt.Errorf("canary failed (%s) and could not be aborted: %w", reason, err)
+	}
+
+	printCanaryAborted(rollout, reason)
+	return &exitError{code: exitCodeRolledBack, err: fmt.Errorf("canary aborted: %s", reason)}
+}
+
+func promoteCanary(client *api.ClientWithResponses, orgSlug, projectSlug, envName string, weight *int) (*api.CanaryRollout, error) {
+	response, err := client.PromoteEnvironmentCanaryWithResponse(
+		context.Background(),
+		orgSlug,
+		projectSlug,
+		envName,
+		api.PromoteEnvironmentCanaryJSONRequestBody{Weight: weight},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to promote canary: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return response.JSON200, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("no canary rollout in progress for %s", envName)
+	default:
+		return nil, fmt.Errorf("failed to promote canary: %s", response.Status())
+	}
+}
+
+func abortCanary(client *api.ClientWithResponses, orgSlug, projectSlug, envName string) (*api.CanaryRollout, error) {
+	response, err := client.AbortEnvironmentCanaryWithResponse(
+		context.Background(),
+		orgSlug,
+		projectSlug,
+		envName,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to abort canary: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return response.JSON200, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("no canary rollout in progress for %s", envName)
+	default:
+		return nil, fmt.Errorf("failed to abort canary: %s", response.Status())
+	}
+}
+
+func printCanaryPaused(weight int) {
+	pterm.Printf("⏸️  Rollout paused at %s\n", pterm.Bold.Sprintf("%d%%", weight))
+	fmt.Printf("Continue with %s or roll back with %s\n", color.CyanString("portway deploy promote"), color.CyanString("portway deploy abort"))
+	fmt.Println()
+}
+
+func printCanaryAborted(rollout *api.CanaryRollout, reason string) {
+	stable := "previous version"
+	if rollout.StableVersion != nil {
+		stable = *rollout.StableVersion
+	}
+
+	result := "aborted"
+	if reason != "" {
+		result = "aborted: " + reason
+	}
+
+	tableData := pterm.TableData{
+		{"Version", "Result"},
+		{rollout.Version, pterm.Red(result)},
+		{stable, pterm.Green("serving all traffic")},
+	}
+	fmt.Println()
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+	fmt.Println()
+}
@@ -7,13 +7,13 @@ import (
 	"cli/pkg/compose/lint"
 	"cli/pkg/config"
 	"cli/pkg/docker"
+	"cli/pkg/registry"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -83,10 +83,16 @@ func printHealth(client *api.ClientWithResponses, deploymentId uuid.UUID) error
 func createEnvironmentComposeFile(
 	client *api.ClientWithResponses,
 	cfg *config.Config,
+	projectSlug string,
 	envName string,
 	version string,
 	composeConfig *types.Project,
 ) (*api.CreateEnvironmentComposeFileResponse, error) {
+	// Upload the x-portway settings with their defaults filled in
+	if err := compose.NormalizeExtensions(composeConfig); err != nil {
+		return nil, err
+	}
+
 	jsonCompose, err := composeConfig.MarshalJSON()
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal compose config: %w", err)
@@ -112,7 +118,7 @@ func createEnvironmentComposeFile(
 	return client.CreateEnvironmentComposeFileWithResponse(
 		context.Background(),
 		orgSlug,
-		cfg.GetProjectSlug(),
+		projectSlug,
 		envName,
 		api.CreateEnvironmentComposeFileJSONRequestBody{
 			ComposeNoramlized: &composeConfigMap,
@@ -122,7 +128,6 @@ func createEnvironmentComposeFile(
 	)
 }
 
-
 func getConfig(configPath string, cmd *cobra.Command, args []string) (*config.Config, error) {
 	cfg, err := config.LoadConfig(configPath)
 	if err != nil {
@@ -139,6 +144,30 @@ func getConfig(configPath string, cmd *cobra.Command, args []string) (*config.Co
 	return cfg, nil
 }
 
+// deployOptions holds the flags shared by every project deployed in a run
+type deployOptions struct {
+	configPath string
+	envName    string
+	version    string
+	envFiles   []string
+
+	readinessTimeout time.Duration
+	autoRollback     bool
+	minHealthScore   float32
+
+	timeout     time.Duration
+	detach      bool
+	confirmDiff bool
+	offlineLint bool
+	checkImages bool
+	strategy    string
+	steps       []int
+	pause       time.Duration
+
+	// checks holds the built-in checks and the custom rules of the config
+	checks *lint.Registry
+}
+
 func printServicesTable(composeConfig *types.Project) {
 	fmt.Println()
 	pterm.Printf("Found %s services\n\n", pterm.Cyan(fmt.Sprintf("%d", len(composeConfig.Services))))
@@ -167,432 +196,615 @@ func printServicesTable(composeConfig *types.Project) {
 	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
 }
 
-func NewDeployCmd() *cobra.Command {
-	var configPath string
-	var project string
-	var envName string
-	var version string
+// deployProject deploys a single project from the config to the selected environment
+func deployProject(
+	cmd *cobra.Command,
+	client *api.ClientWithResponses,
+	cfg *config.Config,
+	orgSlug string,
+	projectSlug string,
+	opts deployOptions,
+) error {
+	project := cfg.Projects[projectSlug]
+	envName := opts.envName
+	version := opts.version
+
+	env := project.GetEnvironment(envName)
+	if envName == "" || env == nil {
+		return fmt.Errorf("no environment %q found for project %q", envName, projectSlug)
+	}
 
-	cmd := &cobra.Command{
-		Use:          "deploy",
-		Short:        "Deploy a Docker Compose file to Portway",
-		Long: `Deploy a Docker Compose file to Portway.
+	composeFiles, composeConfig, err := loadEnvironmentCompose(project, env, opts.configPath, opts.envFiles)
+	if err != nil {
+		return err
+	}
 
-This command will:
-  - Validate your configuration file and environment.
-  - Build and push images as needed.
-  - Deploy your application to the specified environment.
-  - Stream deployment logs and show health checks.
+	sources, err := compose.NewSourceMap(composeFiles)
+	if err != nil {
+		return fmt.Errorf("failed to read compose files: %w", err)
+	}
 
-Examples:
-  portway deploy
-  portway deploy --env production
-  portway deploy --config .portway.yaml --version v1.2.3
+	lintOpts := lint.Options{Client: client, Sources: sources, Policy: cfg.Lint, Registry: opts.checks}
+	if opts.offlineLint {
+		lintOpts.Client = nil
+	} else {
+		if opts.checkImages {
+			lintOpts.Images = registry.NewClient()
+		}
+		lintOpts.Plan, err = lint.FetchPlan(cmd.Context(), client, orgSlug)
+		if err != nil {
+			pterm.Printf("%s Failed to get plan limits. Use %s to deploy with local checks only.\n", pterm.Red("❌"), pterm.Cyan("--offline-lint"))
+			return err
+		}
+	}
 
-For more information, see: https://docs.portway.dev/deploy/cli
-`,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := api.NewViperClientWithResponses()
-			if err != nil {
-				fmt.Println()
-				fmt.Printf("Failed to create client.\n")
-				fmt.Printf("Error message: %s\n", color.RedString(err.Error()))
-				fmt.Println()
-				fmt.Printf("Please check your API key and try again.\n\n")
-				os.Exit(1)
-			}
+	issues, err := lint.Run(cmd.Context(), composeConfig, lintOpts)
+	if err != nil {
+		pterm.Printf("%s Remote lint failed. Use %s to deploy with local checks only.\n", pterm.Red("❌"), pterm.Cyan("--offline-lint"))
+		return fmt.Errorf("failed to lint compose config: %w", err)
+	}
 
-			cfg, err := getConfig(configPath, cmd, args)
-			if err != nil {
-				return err
-			}
+	lint.Display(issues)
 
-			name := cfg.GetProjectSlug()
-			project := cfg.GetProject()
+	if cfg.Lint.Fails(issues) {
+		os.Exit(exitCodeFailed)
+	}
 
-			orgSlug, err := cfg.GetOrgSlug(client)
-			if err != nil {
-				return err
-			}
+	if len(cfg.Lint.Unconfirmed(issues)) > 0 {
+		if err := lint.ConfigLintMessages(); err != nil {
+			return err
+		}
+	}
 
-			env := project.GetEnvironment(envName)
-			if envName == "" || env == nil {
-				return fmt.Errorf("no environment specified or found in config")
-			}
+	if err := checkSecrets(cmd.Context(), client, orgSlug, projectSlug, envName, composeConfig); err != nil {
+		return err
+	}
 
-			configDir := filepath.Dir(configPath)
-			composeFiles, err := env.GetComposeFiles(configDir)
-			if err != nil {
-				pterm.Printf("%s Failed to get compose files\n", pterm.Red("❌"))
-				return fmt.Errorf("failed to get compose files: %w", err)
-			}
+	readiness, err := readinessOptions(env, composeConfig, opts.readinessTimeout)
+	if err != nil {
+		return err
+	}
 
-			if len(composeFiles) == 0 {
-				fmt.Println()
-				fmt.Println(color.RedString("No compose files found."))
-				fmt.Println()
-				os.Exit(1)
-			}
+	printServicesTable(composeConfig)
 
-			composeConfig, err := compose.LoadComposeConfig(composeFiles)
-			if err != nil {
-				pterm.Printf("%s Failed to load compose config\n", pterm.Red("❌"))
-				return fmt.Errorf("failed to get services with build: %w", err)
+	app, err := client.CreateOrUpdateAppWithResponse(cmd.Context(), orgSlug, projectSlug, api.CreateOrUpdateAppJSONRequestBody{
+		Name: &projectSlug,
+	})
+	if err != nil {
+		return err
+	}
+
+	appID := app.JSON200.Id.String()
+
+	// Building images
+	hasImagesToBuild := len(composeConfig.ServicesWithBuild()) > 0
+	if hasImagesToBuild {
+		fmt.Println("🔨 Building all images defined in the compose file...")
+		cmdArgs := []string{"compose"}
+		for _, file := range composeFiles {
+			cmdArgs = append(cmdArgs, "-f", file)
+		}
+		cmdArgs = append(cmdArgs, "build")
+
+		var stdoutBuf, stderrBuf bytes.Buffer
+
+		cmd := exec.Command("docker", cmdArgs...)
+		cmd.Stdout = &stdoutBuf
+		cmd.Stderr = &stderrBuf
+		cmd.Env = append(os.Environ(), "DOCKER_DEFAULT_PLATFORM=linux/amd64")
+
+		buildSpinner := NewSpinnerWithText("Building")
+		go func() {
+			_, _ = buildSpinner.Run()
+		}()
+
+		err := cmd.Run()
+		if err != nil {
+			ExitSpinner(buildSpinner, color.RedString("Failed to build images."))
+			// Print captured logs for debugging
+			if stdoutBuf.Len() > 0 {
+				fmt.Println(color.YellowString("--- docker build stdout ---"))
+				fmt.Print(stdoutBuf.String())
 			}
+			if stderrBuf.Len() > 0 {
+				fmt.Println(color.YellowString("--- docker build stderr ---"))
+				fmt.Print(stderrBuf.String())
+			}
+			fmt.Println("docker", strings.Join(cmdArgs, " "))
+			fmt.Printf("%s Failed to build images: %v\n", pterm.Red("❌"), err)
+			return fmt.Errorf("failed to build images: %w", err)
+		}
 
-			issues, err := lint.Lint(client, composeConfig)
-			if err != nil {
-				return fmt.Errorf("failed to lint compose config: %w", err)
+		ExitSpinner(buildSpinner, "Docker images built.")
+
+		fmt.Println()
+		pterm.Println("ℹ️  Built Image IDs")
+		serviceImages := map[string]string{}
+		for serviceName, service := range composeConfig.Services {
+			if service.Build == nil {
+				continue
 			}
 
-			if len(issues) > 0 {
-				lint.DisplayValidationResults(issues)
+			candidates := []string{}
+			if service.Image != "" {
+				candidates = append(candidates, service.Image, service.Image+":latest")
+			} else {
+				baseUnderscore := fmt.Sprintf("%s_%s", composeConfig.Name, serviceName)
+				baseHyphen := fmt.Sprintf("%s-%s", composeConfig.Name, serviceName)
+				candidates = append(candidates,
+					baseUnderscore,
+					baseUnderscore+":latest",
+					baseHyphen,
+					baseHyphen+":latest",
+				)
 			}
 
-			for _, issue := range issues {
-				if strings.ToLower(string(issue.Severity)) == "error" {
-					os.Exit(1)
+			var foundRef string
+			var imageID string
+			for _, ref := range candidates {
+				id, idErr := docker.GetImageID(ref)
+				if idErr == nil {
+					foundRef = ref
+					imageID = id
+					break
 				}
 			}
 
-			if len(issues) > 0 {
-				lint.ConfigLintMessages()
+			if foundRef == "" {
+				pterm.Printf("%s Could not determine image ID for %s\n", pterm.Yellow("⚠️"), pterm.Bold.Sprint(serviceName))
+				continue
 			}
 
-			printServicesTable(composeConfig)
+			pterm.Printf("🏷️  %s → %s (%s)\n", pterm.Bold.Sprint(serviceName), pterm.Cyan(foundRef), pterm.Green(imageID))
 
-			app, err := client.CreateOrUpdateAppWithResponse(cmd.Context(), orgSlug, cfg.GetProjectSlug(), api.CreateOrUpdateAppJSONRequestBody{
-				Name: &name,
-			})
-			if err != nil {
-				return err
+			newRef := fmt.Sprintf("registry.portway.dev/%s/%s:%s-%s", appID, serviceName, envName, imageID)
+
+			if err := docker.TagImage(foundRef, newRef); err != nil {
+				pterm.Printf("%s Failed to retag: %s → %s (%s)\n", pterm.Red("❌"), pterm.Cyan(foundRef), pterm.Green(newRef), err.Error())
+				os.Exit(exitCodeFailed)
 			}
+			pterm.Printf("  Retagged to %s\n", pterm.Cyan(newRef))
+			serviceImages[serviceName] = newRef
 
-			appID := app.JSON200.Id.String()
+			serviceCopy := service
+			serviceCopy.Image = newRef
+			composeConfig.Services[serviceName] = serviceCopy
+		}
+		fmt.Println()
 
-			// Building images
-			hasImagesToBuild := len(composeConfig.ServicesWithBuild()) > 0
-			if hasImagesToBuild {
-				fmt.Println("🔨 Building all images defined in the compose file...")
-				cmdArgs := []string{"compose"}
-				for _, file := range composeFiles {
-					cmdArgs = append(cmdArgs, "-f", file)
-				}
-				cmdArgs = append(cmdArgs, "build")
+		// Push images to the distributed registry, passing in the API key
+		pterm.Println("🚀 Pushing images to registry...")
+		apiKey := viper.GetString("token")
+		if strings.TrimSpace(apiKey) == "" {
+			pterm.Printf("%s Missing API token. Please set it with 'portway auth login' or configure 'token' in config.\n", pterm.Red("❌"))
+			os.Exit(exitCodeFailed)
+		}
+		loginCmd := exec.Command("docker", "login", "registry.portway.dev", "-u", "portway", "--password-stdin")
+		loginCmd.Stdin = strings.NewReader(apiKey)
+		loginCmd.Stdout = os.Stdout
+		loginCmd.Stderr = os.Stderr
+		if err := loginCmd.Run(); err != nil {
+			pterm.Printf("%s Failed to login to registry. Please verify your API token.\n", pterm.Red("❌"))
+			os.Exit(exitCodeFailed)
+		}
 
-				var stdoutBuf, stderrBuf bytes.Buffer
+		fmt.Println()
 
-				cmd := exec.Command("docker", cmdArgs...)
-				cmd.Stdout = &stdoutBuf
-				cmd.Stderr = &stderrBuf
-				cmd.Env = append(os.Environ(), "DOCKER_DEFAULT_PLATFORM=linux/amd64")
+		for _, imageRef := range serviceImages {
+			pterm.Printf("📤 Pushing %s...\n", pterm.Cyan(imageRef))
+			if err := docker.PushImage(imageRef); err != nil {
+				pterm.Printf("%s Failed to push image %s: %s\n", pterm.Red("❌"), pterm.Cyan(imageRef), err.Error())
+				os.Exit(exitCodeFailed)
+			}
+			pterm.Printf("%s Successfully pushed %s\n", pterm.Green("✅"), pterm.Cyan(imageRef))
+		}
 
-				buildSpinner := NewSpinnerWithText("Building")
-				go func() {
-					_, _ = buildSpinner.Run()
-				}()
+		fmt.Println()
+	}
 
-				err := cmd.Run()
-				if err != nil {
-					ExitSpinner(buildSpinner, color.RedString("Failed to build images."))
-					// Print captured logs for debugging
-					if stdoutBuf.Len() > 0 {
-						fmt.Println(color.YellowString("--- docker build stdout ---"))
-						fmt.Print(stdoutBuf.String())
-					}
-					if stderrBuf.Len() > 0 {
-						fmt.Println(color.YellowString("--- docker build stderr ---"))
-						fmt.Print(stderrBuf.String())
-					}
-					fmt.Println("docker", strings.Join(cmdArgs, " "))
-					fmt.Printf("%s Failed to build images: %v\n", pterm.Red("❌"), err)
-					return fmt.Errorf("failed to build images: %w", err)
-				}
+	if version == "" {
+		version, _ = determineVersion()
 
-				ExitSpinner(buildSpinner, "Docker images built.")
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewInput().
+					Title("Enter a version").
+					Value(&version).
+					Placeholder("Enter a version"),
+			),
+		)
 
-				fmt.Println()
-				pterm.Println("ℹ️  Built Image IDs")
-				serviceImages := map[string]string{}
-				for serviceName, service := range composeConfig.Services {
-					if service.Build == nil {
-						continue
-					}
+		err := form.Run()
+		if err != nil {
+			return fmt.Errorf("failed to get version input: %w", err)
+		}
+	}
 
-					candidates := []string{}
-					if service.Image != "" {
-						candidates = append(candidates, service.Image, service.Image+":latest")
-					} else {
-						baseUnderscore := fmt.Sprintf("%s_%s", composeConfig.Name, serviceName)
-						baseHyphen := fmt.Sprintf("%s-%s", composeConfig.Name, serviceName)
-						candidates = append(candidates,
-							baseUnderscore,
-							baseUnderscore+":latest",
-							baseHyphen,
-							baseHyphen+":latest",
-						)
-					}
+	rollbackSettings := autoRollbackSettingsOrDefault(env, opts)
+	previous, err := getDeployedComposeFile(cmd.Context(), client, orgSlug, projectSlug, envName)
+	if err != nil {
+		if rollbackSettings.Enabled && opts.strategy != strategyCanary {
+			return err
+		}
+		pterm.Printf("%s Could not fetch the deployed version: %s\n", pterm.Yellow("⚠️"), err.Error())
+	}
 
-					var foundRef string
-					var imageID string
-					for _, ref := range candidates {
-						id, idErr := docker.GetImageID(ref)
-						if idErr == nil {
-							foundRef = ref
-							imageID = id
-							break
-						}
-					}
+	if err := reviewChanges(previous, composeConfig, envName, opts.confirmDiff); err != nil {
+		return err
+	}
 
-					if foundRef == "" {
-						pterm.Printf("%s Could not determine image ID for %s\n", pterm.Yellow("⚠️"), pterm.Bold.Sprint(serviceName))
-						continue
-					}
+	// The previous version is restored by auto-rollback, or on request when
+	// the deploy is interrupted
+	var plan *rollbackPlan
+	if opts.strategy != strategyCanary && !opts.detach {
+		settings := rollbackSettings
+		if previous == nil && settings.Enabled {
+			fmt.Println(color.YellowString("Nothing is deployed to %s yet, so a failed deployment cannot be rolled back.", envName))
+			fmt.Println()
+		}
+		plan = &rollbackPlan{auto: settings.Enabled, minHealthScore: settings.MinHealthScore, previous: previous}
+	}
 
-					pterm.Printf("🏷️  %s → %s (%s)\n", pterm.Bold.Sprint(serviceName), pterm.Cyan(foundRef), pterm.Green(imageID))
+	composeFileResponse, err := createEnvironmentComposeFile(
+		client,
+		cfg,
+		projectSlug,
+		envName,
+		version,
+		composeConfig,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create compose file: %w", err)
+	}
 
-					newRef := fmt.Sprintf("registry.portway.dev/%s/%s:%s-%s", appID, serviceName, envName, imageID)
+	if composeFileResponse.StatusCode() != 200 {
+		fmt.Println()
+		color.Red("Failed to create compose file.")
+		fmt.Printf("Status: %s\n", color.YellowString(strconv.Itoa(composeFileResponse.StatusCode())))
+		fmt.Println()
+		return fmt.Errorf("failed to create compose file")
+	}
 
-					if err := docker.TagImage(foundRef, newRef); err != nil {
-						pterm.Printf("%s Failed to retag: %s → %s (%s)\n", pterm.Red("❌"), pterm.Cyan(foundRef), pterm.Green(newRef), err.Error())
-						os.Exit(1)
-					}
-					pterm.Printf("  Retagged to %s\n", pterm.Cyan(newRef))
-					serviceImages[serviceName] = newRef
+	fmt.Println()
+	fmt.Printf("Created version %s of compose file.\n", color.GreenString(version))
+	fmt.Println()
 
-					serviceCopy := service
-					serviceCopy.Image = newRef
-					composeConfig.Services[serviceName] = serviceCopy
-				}
-				fmt.Println()
+	deployResponse, err := client.DeployEnvironmentComposeFileWithResponse(
+		context.Background(),
+		composeFileResponse.JSON200.Id,
+		deployRequest(opts),
+	)
 
-				// Push images to the distributed registry, passing in the API key
-				pterm.Println("🚀 Pushing images to registry...")
-				apiKey := viper.GetString("token")
-				if strings.TrimSpace(apiKey) == "" {
-					pterm.Printf("%s Missing API token. Please set it with 'portway auth login' or configure 'token' in config.\n", pterm.Red("❌"))
-					os.Exit(1)
-				}
-				loginCmd := exec.Command("docker", "login", "registry.portway.dev", "-u", "portway", "--password-stdin")
-				loginCmd.Stdin = strings.NewReader(apiKey)
-				loginCmd.Stdout = os.Stdout
-				loginCmd.Stderr = os.Stderr
-				if err := loginCmd.Run(); err != nil {
-					pterm.Printf("%s Failed to login to registry. Please verify your API token.\n", pterm.Red("❌"))
-					os.Exit(1)
-				}
+	if err != nil {
+		return fmt.Errorf("failed to deploy environment compose file: %w", err)
+	}
 
-				fmt.Println()
+	if deployResponse.StatusCode() != 200 || deployResponse.JSON200 == nil {
+		fmt.Println()
+		color.Red("Failed to deploy environment compose file.\n")
+		fmt.Println()
+		return fmt.Errorf("failed to deploy environment compose file")
+	}
 
-				for _, imageRef := range serviceImages {
-					pterm.Printf("📤 Pushing %s...\n", pterm.Cyan(imageRef))
-					if err := docker.PushImage(imageRef); err != nil {
-						pterm.Printf("%s Failed to push image %s: %s\n", pterm.Red("❌"), pterm.Cyan(imageRef), err.Error())
-						os.Exit(1)
-					}
-					pterm.Printf("%s Successfully pushed %s\n", pterm.Green("✅"), pterm.Cyan(imageRef))
-				}
+	ids := deploymentIds(deployResponse)
 
-				fmt.Println()
-			}
+	if len(ids) == 0 {
+		fmt.Println()
+		color.Yellow("No deployment targets found.")
+		fmt.Println("This can happen if you have deleted existing targets, have no branches configured, or have not set up any deployment targets.")
+		fmt.Println()
+		return nil
+	}
 
-			if version == "" {
-				version, _ = determineVersion()
+	for _, id := range ids {
+		fmt.Printf("Started deployment %s\n", color.CyanString(id.String()))
+	}
+	fmt.Println()
 
-				form := huh.NewForm(
-					huh.NewGroup(
-						huh.NewInput().
-							Title("Enter a version").
-							Value(&version).
-							Placeholder("Enter a version"),
-					),
-				)
+	if opts.detach {
+		printDetached(ids)
+		return nil
+	}
 
-				err := form.Run()
-				if err != nil {
-					return fmt.Errorf("failed to get version input: %w", err)
-				}
-			}
+	deployURL := fmt.Sprintf("https://%s-%s.%s.portway.app", app.JSON200.Slug, orgSlug, env.Region)
+	if plan != nil {
+		plan.readiness = readiness
+		plan.deployURL = deployURL
+		plan.timeout = opts.timeout
+	}
 
-			composeFileResponse, err := createEnvironmentComposeFile(
-				client,
-				cfg,
-				envName,
-				version,
-				composeConfig,
-			)
-			if err != nil {
-				return fmt.Errorf("failed to create compose file: %w", err)
-			}
+	var canary *canaryRollout
+	if opts.strategy == strategyCanary {
+		canary = &canaryRollout{
+			client:         client,
+			orgSlug:        orgSlug,
+			projectSlug:    projectSlug,
+			envName:        envName,
+			version:        version,
+			steps:          opts.steps,
+			pause:          opts.pause,
+			minHealthScore: autoRollbackSettingsOrDefault(env, opts).MinHealthScore,
+			readiness:      readiness,
+			deployURL:      deployURL,
+			timeout:        opts.timeout,
+		}
+	}
 
-			if composeFileResponse.StatusCode() != 200 {
-				fmt.Println()
-				color.Red("Failed to create compose file.")
-				fmt.Printf("Status: %s\n", color.YellowString(strconv.Itoa(composeFileResponse.StatusCode())))
-				fmt.Println()
-				return fmt.Errorf("failed to create compose file")
+	spinner, done := startDeploySpinner("Deploying")
+
+	// Wait for all deployments to complete or spinner interruption
+	failed, err := waitForDeployments(client, spinner, done, ids, opts.timeout)
+	if errors.Is(err, errInterrupted) {
+		return handleInterrupt(client, ids, func() error {
+			if canary != nil {
+				return canary.abort("interrupted")
 			}
+			return rollback(client, plan, version, "interrupted")
+		}, canary != nil || (plan != nil && plan.previous != nil))
+	}
+	if err != nil {
+		return err
+	}
 
+	if failed != nil {
+		ExitSpinner(spinner, color.RedString(failureMessage(failed)))
+		fmt.Println()
+		printHealthOrError(client, failed.ID)
+		reason := fmt.Sprintf("deployment %s", failed.State)
+		if canary != nil {
+			return canary.abort(reason)
+		}
+		if plan != nil && plan.auto {
 			fmt.Println()
-			fmt.Printf("Created version %s of compose file.\n", color.GreenString(version))
-			fmt.Println()
+			return rollback(client, plan, version, reason)
+		}
+		return fmt.Errorf("%s", reason)
+	}
 
-			deployResponse, err := client.DeployEnvironmentComposeFileWithResponse(
-				context.Background(),
-				composeFileResponse.JSON200.Id,
-			)
+	ExitSpinner(spinner, "Deployments completed.")
+	fmt.Println()
 
-			if err != nil {
-				return fmt.Errorf("failed to deploy environment compose file: %w", err)
-			}
+	if canary != nil {
+		paused, err := canary.run(ids)
+		if err != nil {
+			return err
+		}
+		if paused {
+			return nil
+		}
+	}
 
-			if deployResponse.StatusCode() != 200 {
-				fmt.Println()
-				color.Red("Failed to deploy environment compose file.\n")
-				fmt.Println()
-				return fmt.Errorf("failed to deploy environment compose file")
+	if plan != nil && plan.auto {
+		healthy, err := checkHealthScore(client, ids, plan.minHealthScore)
+		if err != nil {
+			return err
+		}
+		if !healthy {
+			return rollback(client, plan, version, fmt.Sprintf("health score below %.0f", plan.minHealthScore))
+		}
+	}
+
+	if readiness != nil {
+		result, err := waitForReadiness(deployURL, readiness)
+		printReadinessReport(result, readiness)
+		if err != nil {
+			fmt.Println(color.RedString("Readiness check failed: %s", err))
+			fmt.Println()
+			printHealthOrError(client, ids[0])
+			fmt.Println()
+			if plan != nil && plan.auto {
+				return rollback(client, plan, version, "readiness check failed")
 			}
+			return &exitError{code: exitCodeNotReady, err: fmt.Errorf("readiness check failed: %w", err)}
+		}
+	}
 
-			deployments := deployResponse.JSON200.Deployments
+	fmt.Println()
+	printHealth(client, ids[0])
 
-			if len(deployments) == 0 {
-				fmt.Println()
-				color.Yellow("No deployment targets found.")
-				fmt.Println("This can happen if you have deleted existing targets, have no branches configured, or have not set up any deployment targets.")
-				fmt.Println()
-				return nil
-			}
+	fmt.Printf("Deployment complete. Access your application at:\n")
+	fmt.Println(color.BlueString(deployURL))
+	for _, domain := range env.Domains {
+		fmt.Println(color.BlueString("https://" + domain))
+	}
+	fmt.Println()
 
-			spinner := NewSpinner()
+	if len(env.Domains) > 0 {
+		fmt.Printf("Check custom domain DNS and certificates with %s\n", color.CyanString("portway domains verify --env %s", envName))
+		fmt.Println()
+	}
 
-			// Channel to signal spinner completion/interruption
-			done := make(chan error, 1)
+	return nil
+}
 
-			go func() {
-				// Run spinner in background and capture if it was interrupted
-				model, err := spinner.Run()
-				if err != nil {
-					done <- err
-					return
-				}
+func NewDeployCmd() *cobra.Command {
+	var opts deployOptions
+	var projectName string
+	var allProjects bool
 
-				// Check if the spinner was quitting (possibly due to Ctrl+C)
-				if spinnerModel, ok := model.(spinnerModel); ok && spinnerModel.quitting {
-					done <- fmt.Errorf("operation interrupted by user")
-					return
-				}
+	cmd := &cobra.Command{
+		Use:   "deploy",
+		Short: "Deploy a Docker Compose file to Portway",
+		Long: `Deploy a Docker Compose file to Portway.
 
-				done <- nil
-			}()
-
-			// Wait for all deployments to complete or spinner interruption
-			for _, d := range deployments {
-				for {
-					// Check if spinner was interrupted
-					select {
-					case err := <-done:
-						if err != nil {
-							fmt.Println()
-							fmt.Println(color.RedString("Deployment interrupted."))
-							fmt.Println()
-							return err
-						}
-					default:
-						// Continue with deployment check
-					}
+This command will:
+  - Validate your configuration file and environment.
+  - Build and push images as needed.
+  - Deploy your application to the specified environment.
+  - Stream deployment logs and show health checks.
 
-					deployment, err := client.GetDeploymentWithResponse(
-						context.Background(),
-						d.Id.String(),
-					)
+Compose ${VAR} interpolation only uses values from the environment's
+variables and env-files in the config, --env-file, and shell variables listed
+under interpolation.allow-env. Your local .env and shell are never read
+implicitly, and unresolved variables fail the deploy.
+
+Before uploading, deploy prints what changes compared to the version running
+in the environment. Use --confirm-diff to review the changes before they are
+deployed, or portway diff to only see them.
+
+Once deployed, the application URL is probed until it responds. Configure the
+probe with the readiness key of the environment in .portway.yaml, or with
+x-portway.readiness in the compose file:
+
+  readiness:
+    path: /healthz
+    timeout: 5m
+    interval: 5s
+    expected-status: [200]
+    body-match: "ok"
+
+With --auto-rollback, or auto-rollback.enabled in the environment config, a
+deployment that fails, reports a health score below the minimum or never
+becomes ready is replaced by the previously deployed version:
+
+  auto-rollback:
+    enabled: true
+    min-health-score: 50
+
+With --strategy canary, the new version first receives a share of the
+traffic and is promoted through --steps. At each step the canary is watched
+for --pause: it is aborted when its health score drops below the minimum,
+its pods restart, or the readiness probe keeps failing. With --pause 0 the
+rollout waits at each step for portway deploy promote or portway deploy abort.
+
+Press Ctrl+C while waiting to detach, cancel the deployment or roll back to
+the previous version. Use --detach to return as soon as the deployment has
+started; follow it with portway deployments status <id> --wait.
+
+Exit codes: 1 when the deployment fails, 3 when the application never
+becomes ready, 4 when the deployment failed and the previous version was
+restored or the canary was aborted.
+
+When the config declares several projects, select one with --project or
+deploy all of them with --all-projects. Projects are deployed in dependency
+order, following the depends-on key of each project. Each project waits
+for the previous one, so --detach is not available; detaching on Ctrl+C
+stops before the next project.
 
-					if err != nil {
-						ExitSpinner(spinner, color.RedString("Deployment failed."))
-						fmt.Println()
-						return fmt.Errorf("failed to get deployment: %w", err)
-					}
+Examples:
+  portway deploy
+  portway deploy --env production
+  portway deploy --config .portway.yaml --version v1.2.3
+  portway deploy --project api
+  portway deploy --all-projects
+  portway deploy --env-file deploy/production.env
+  portway deploy --auto-rollback --min-health-score 80
+  portway deploy --strategy canary --steps 10,50,100 --pause 5m
+  portway deploy --detach
 
-					logs := *deployment.JSON200.Logs
-					for _, l := range logs {
-						log := logMsg{
-							timestamp: l.Timestamp,
-							log:       l.Log,
-							stream:    l.Stream,
-						}
-						spinner.Send(log)
-					}
+For more information, see: https://docs.portway.dev/deploy/cli
+`,
+		RunE: withExitCode(func(cmd *cobra.Command, args []string) error {
+			client, err := api.NewViperClientWithResponses()
+			if err != nil {
+				fmt.Println()
+				fmt.Printf("Failed to create client.\n")
+				fmt.Printf("Error message: %s\n", color.RedString(err.Error()))
+				fmt.Println()
+				fmt.Printf("Please check your API key and try again.\n\n")
+				os.Exit(exitCodeFailed)
+			}
 
-					if deployment.JSON200.Status == "failed" {
-						message := "An error happened while trying to deploy your application."
-						ExitSpinner(spinner, color.RedString(message))
-						fmt.Println()
-						err = printHealth(client, *d.Id)
-						if err != nil {
-							fmt.Println()
-							fmt.Println(color.RedString("Failed to print health."))
-							fmt.Println(color.RedString(err.Error()))
-							fmt.Println()
-						}
-						return fmt.Errorf("deployment failed")
-					}
+			cfg, err := getConfig(opts.configPath, cmd, args)
+			if err != nil {
+				return err
+			}
 
-					if deployment.JSON200.Status == "deployed" {
-						break
-					}
+			if err := cfg.Lint.Validate(); err != nil {
+				return err
+			}
+			opts.checks, err = cfg.LintRegistry()
+			if err != nil {
+				return err
+			}
 
-					// Sleep briefly before checking again
-					time.Sleep(1 * time.Second)
-				}
+			if err := validateStrategy(opts); err != nil {
+				return err
 			}
 
-			deployURL := fmt.Sprintf("https://%s-%s.%s.portway.app", app.JSON200.Slug, orgSlug, env.Region)
-			for {
-				// Try using Go's http client to check for cert errors, fallback to curl if available
-				client := &http.Client{
-					Timeout: 10 * time.Second,
-					Transport: &http.Transport{
-						// Intentionally do not skip cert verification here
-					},
-				}
-				resp, err := client.Get(deployURL)
+			if opts.detach && (opts.autoRollback || (opts.strategy == strategyCanary && opts.pause > 0)) {
+				return fmt.Errorf("--detach cannot be used with --auto-rollback or a timed canary --pause")
+			}
+
+			if allProjects && projectName != "" {
+				return fmt.Errorf("--project and --all-projects cannot be used together")
+			}
+
+			if allProjects && opts.detach {
+				return fmt.Errorf("--detach cannot be used with --all-projects, as each project waits for the projects it depends on")
+			}
+
+			var projects []string
+			if allProjects {
+				projects, err = cfg.ProjectDeployOrder()
 				if err != nil {
-					// Check for x509 unknown authority or self-signed cert error
-					if strings.Contains(err.Error(), "x509: certificate signed by unknown authority") ||
-						strings.Contains(err.Error(), "x509: certificate is valid for") ||
-						strings.Contains(err.Error(), "certificate has expired or is not yet valid") ||
-						strings.Contains(err.Error(), "x509:") {
-						fmt.Printf("Waiting for SSL certificate to be valid at %s ...\n", deployURL)
-						time.Sleep(5 * time.Second)
-						continue
-					}
-					// If error is not cert related, break and continue
-					break
+					return err
 				}
-				if resp != nil {
-					resp.Body.Close()
+			} else {
+				slug, err := cfg.ResolveProjectSlug(projectName)
+				if err != nil {
+					return err
 				}
-				// If we got here, no cert error
-				break
+				projects = []string{slug}
 			}
 
-			ExitSpinner(spinner, "Deployments completed.")
-			fmt.Println()
-			fmt.Println()
+			orgSlug, err := cfg.GetOrgSlug(client)
+			if err != nil {
+				return err
+			}
 
-			printHealth(client, *deployments[0].Id)
+			if len(projects) > 1 {
+				fmt.Println()
+				fmt.Printf("Deploying %s projects in order: %s\n", color.CyanString(strconv.Itoa(len(projects))), strings.Join(projects, " → "))
+			}
 
-			fmt.Printf("Deployment complete. Access your application at:\n")
-			fmt.Println(color.BlueString(deployURL))
-			fmt.Println()
+			for i, projectSlug := range projects {
+				if len(projects) > 1 {
+					fmt.Println()
+					pterm.Printf("📦 Project %s (%d/%d)\n", pterm.Bold.Sprint(projectSlug), i+1, len(projects))
+				}
+
+				err := deployProject(cmd, client, cfg, orgSlug, projectSlug, opts)
+				if errors.Is(err, errDetached) {
+					remaining := projects[i+1:]
+					if len(remaining) == 0 {
+						return nil
+					}
+					pterm.Printf("%s Not deployed, as %s has not finished: %s\n", pterm.Yellow("⚠️"), pterm.Bold.Sprint(projectSlug), strings.Join(remaining, ", "))
+					return fmt.Errorf("project %s: %w, %d project(s) not deployed", projectSlug, err, len(remaining))
+				}
+				if err != nil {
+					if len(projects) > 1 {
+						return fmt.Errorf("project %s: %w", projectSlug, err)
+					}
+					return err
+				}
+			}
 
 			return nil
-		},
+		}),
 	}
 
-	cmd.Flags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file to deploy")
-	cmd.Flags().StringVarP(&envName, "env", "e", "production", "Environment to deploy to")
-	cmd.Flags().StringVarP(&version, "version", "v", "", "Version to deploy")
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project to deploy")
+	cmd.Flags().StringVarP(&opts.configPath, "config", "c", ".portway.yaml", "Config file to deploy")
+	cmd.Flags().StringVarP(&opts.envName, "env", "e", "production", "Environment to deploy to")
+	cmd.Flags().StringVarP(&opts.version, "version", "v", "", "Version to deploy")
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project to deploy")
+	cmd.Flags().BoolVar(&allProjects, "all-projects", false, "Deploy every project in the config in dependency order")
+	cmd.Flags().StringSliceVar(&opts.envFiles, "env-file", nil, "Env file used for ${VAR} interpolation in compose files (can be repeated)")
+	cmd.Flags().BoolVar(&opts.autoRollback, "auto-rollback", false, "Redeploy the previous version when the deployment fails or is unhealthy")
+	cmd.Flags().Float32Var(&opts.minHealthScore, "min-health-score", 0, "Health score (0-100) below which --auto-rollback rolls back and canaries are aborted (default 50)")
+	cmd.Flags().DurationVar(&opts.timeout, "timeout", 30*time.Minute, "How long to wait for all deployments to finish")
+	cmd.Flags().BoolVar(&opts.offlineLint, "offline-lint", false, "Lint with local checks only, without the Portway API or image registries")
+	cmd.Flags().BoolVar(&opts.checkImages, "check-images", false, "Look up images in their registries to check they exist, run on linux/amd64 and are not too large")
+	cmd.Flags().BoolVar(&opts.confirmDiff, "confirm-diff", false, "Ask for confirmation after showing the changes compared to the deployed version")
+	cmd.Flags().BoolVar(&opts.detach, "detach", false, "Return once the deployment has started, without waiting for it")
+	cmd.Flags().StringVar(&opts.strategy, "strategy", strategyAll, "Rollout strategy: all or canary")
+	cmd.Flags().IntSliceVar(&opts.steps, "steps", []int{10, 50, 100}, "Percentages of traffic routed to the canary at each step")
+	cmd.Flags().DurationVar(&opts.pause, "pause", 5*time.Minute, "How long to watch the canary at each step (0 pauses until deploy promote)")
+	cmd.Flags().DurationVar(&opts.readinessTimeout, "readiness-timeout", 0, "How long to wait for the application to become ready (overrides the configured timeout)")
+
+	cmd.AddCommand(NewPromoteCmd())
+	cmd.AddCommand(NewAbortCmd())
 
 	return cmd
 }
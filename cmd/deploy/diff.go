@@ -0,0 +1,177 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/compose"
+	"cli/pkg/config"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/huh"
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/fatih/color"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// builtImagePlaceholder stands in for images that deploy builds and pushes
+const builtImagePlaceholder = "(built on deploy)"
+
+// loadEnvironmentCompose resolves and loads the compose files of an
+// environment with its interpolation sources
+func loadEnvironmentCompose(project *config.ProjectConfig, env *config.Environment, configPath string, envFiles []string) ([]string, *types.Project, error) {
+	configDir := filepath.Dir(configPath)
+	composeFiles, err := env.GetComposeFiles(configDir)
+	if err != nil {
+		pterm.Printf("%s Failed to get compose files\n", pterm.Red("❌"))
+		return nil, nil, fmt.Errorf("failed to get compose files: %w", err)
+	}
+
+	if len(composeFiles) == 0 {
+		fmt.Println()
+		fmt.Println(color.RedString("No compose files found."))
+		fmt.Println()
+		os.Exit(exitCodeFailed)
+	}
+
+	composeConfig, err := loadComposeProject(composeFiles, project.LoadOptions(env, configDir, envFiles))
+	if err != nil {
+		pterm.Printf("%s Failed to load compose config\n", pterm.Red("❌"))
+		return nil, nil, fmt.Errorf("failed to load compose config: %w", err)
+	}
+
+	return composeFiles, composeConfig, nil
+}
+
+// reviewChanges prints what the deploy changes compared to the deployed
+// version and, with confirm, asks whether to continue
+func reviewChanges(previous *api.EnvironmentComposeFile, composeConfig *types.Project, envName string, confirm bool) error {
+	if previous != nil {
+		local, err := compose.NormalizeProject(composeConfig)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println()
+		pterm.Printf("Changes compared to version %s deployed to %s\n", pterm.Cyan(previous.Version), pterm.Cyan(envName))
+		printComposeDiff(compose.Diff(previous.Compose, local))
+	}
+
+	if !confirm {
+		return nil
+	}
+
+	confirmed := false
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(fmt.Sprintf("Deploy these changes to %s?", envName)).
+				Value(&confirmed),
+		),
+	)
+	if err := form.Run(); err != nil {
+		return fmt.Errorf("failed to run prompt: %w", err)
+	}
+	if !confirmed {
+		return fmt.Errorf("deploy cancelled")
+	}
+
+	return nil
+}
+
+func printComposeDiff(diff *compose.ProjectDiff) {
+	fmt.Println()
+	if diff.Empty() {
+		fmt.Println(color.GreenString("No changes to services."))
+		fmt.Println()
+		return
+	}
+
+	for _, service := range diff.Services {
+		switch service.Kind {
+		case compose.ChangeAdded:
+			fmt.Println(color.GreenString("+ %s (new service)", service.Name))
+		case compose.ChangeRemoved:
+			fmt.Println(color.RedString("- %s (removed)", service.Name))
+		default:
+			fmt.Println(color.YellowString("~ %s", service.Name))
+		}
+
+		for _, change := range service.Changes {
+			switch change.Kind {
+			case compose.ChangeAdded:
+				fmt.Printf("    %s %s\n", color.GreenString("+ %s:", change.Field), change.New)
+			case compose.ChangeRemoved:
+				fmt.Printf("    %s %s\n", color.RedString("- %s:", change.Field), change.Old)
+			default:
+				if change.Old == "" {
+					fmt.Printf("    %s %s\n", color.YellowString("~ %s:", change.Field), change.New)
+				} else {
+					fmt.Printf("    %s %s → %s\n", color.YellowString("~ %s:", change.Field), change.Old, change.New)
+				}
+			}
+		}
+	}
+	fmt.Println()
+}
+
+func NewDiffCmd() *cobra.Command {
+	var configPath string
+	var projectName string
+	var envName string
+	var envFiles []string
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show what a deploy would change",
+		Long: `Compare your local compose files with the version deployed to an
+environment, service by service: images, environment variables (masked),
+ports, volumes, replicas, and added or removed services.
+
+Images that deploy builds are shown as "` + builtImagePlaceholder + `".
+
+Examples:
+  portway diff
+  portway diff --env staging
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := config.ResolveTarget(configPath, projectName, envName)
+			if err != nil {
+				return err
+			}
+
+			_, composeConfig, err := loadEnvironmentCompose(t.Config.Projects[t.ProjectSlug], t.Environment, configPath, envFiles)
+			if err != nil {
+				return err
+			}
+
+			for name, service := range composeConfig.Services {
+				if service.Build != nil {
+					service.Image = builtImagePlaceholder
+					composeConfig.Services[name] = service
+				}
+			}
+
+			previous, err := getDeployedComposeFile(cmd.Context(), t.Client, t.OrgSlug, t.ProjectSlug, t.EnvName)
+			if err != nil {
+				return err
+			}
+			if previous == nil {
+				pterm.Info.Printf("Nothing is deployed to %s yet\n", t.EnvName)
+				return nil
+			}
+
+			return reviewChanges(previous, composeConfig, t.EnvName, false)
+		},
+	}
+
+	cmd.Flags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file to use")
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project to compare")
+	cmd.Flags().StringVarP(&envName, "env", "e", "production", "Environment to compare with")
+	cmd.Flags().StringSliceVar(&envFiles, "env-file", nil, "Env file used for ${VAR} interpolation in compose files (can be repeated)")
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package deploy
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// exitError ends deploy with code rather than exitCodeFailed. Helpers return
+// it instead of exiting, so deferred cleanup runs before the process exits.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string { return e.err.Error() }
+
+func (e *exitError) Unwrap() error { return e.err }
+
+// withExitCode exits with the code of an exitError returned by run. The
+// failure has been reported by the time it is returned, so it is not printed
+// again.
+func withExitCode(run func(cmd *cobra.Command, args []string) error) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		err := run(cmd, args)
+		var exitErr *exitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
+		return err
+	}
+}
@@ -0,0 +1,73 @@
+package deploy
+
+import (
+	"cli/pkg/compose"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/fatih/color"
+	"github.com/pterm/pterm"
+)
+
+// loadComposeProject loads the compose files using only the interpolation
+// sources declared in the config or passed with --env-file.
+func loadComposeProject(composeFiles []string, loadOpts compose.LoadOptions) (*types.Project, error) {
+	composeConfig, report, err := compose.LoadComposeConfigWithOptions(composeFiles, loadOpts)
+
+	var unresolved *compose.UnresolvedVariablesError
+	if errors.As(err, &unresolved) {
+		fmt.Println()
+		fmt.Printf("%s  The compose file references variables that are not set:\n\n", color.RedString("❌"))
+		for _, name := range unresolved.Names {
+			fmt.Printf("   - %s\n", color.New(color.Bold).Sprint(name))
+		}
+		fmt.Println()
+		fmt.Printf("%s Set them under %s of the environment in .portway.yaml, pass %s,\n", color.GreenString("💡"), color.CyanString("variables"), color.CyanString("--env-file"))
+		fmt.Printf("   or allow them from your shell with %s. Use %s for optional values.\n", color.CyanString("interpolation.allow-env"), color.CyanString("${VAR:-default}"))
+		fmt.Println()
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	printInterpolationReport(report)
+
+	return composeConfig, nil
+}
+
+func printInterpolationReport(report *compose.InterpolationReport) {
+	if report == nil || len(report.Variables) == 0 {
+		return
+	}
+
+	wd, _ := os.Getwd()
+
+	fmt.Println()
+	pterm.Printf("Interpolated %s variable(s)\n\n", pterm.Cyan(fmt.Sprintf("%d", len(report.Variables))))
+
+	tableData := pterm.TableData{{"Variable", "Source"}}
+	for _, variable := range report.Variables {
+		source := string(variable.Source)
+		switch variable.Source {
+		case compose.SourceEnvFile:
+			file := variable.File
+			if rel, err := filepath.Rel(wd, file); err == nil && wd != "" {
+				file = rel
+			}
+			source = "env file " + file
+		case compose.SourceConfig:
+			source = ".portway.yaml variables"
+		case compose.SourceShell:
+			source = "shell (allow-env)"
+		}
+		tableData = append(tableData, []string{
+			pterm.Bold.Sprint(variable.Name),
+			pterm.Gray(source),
+		})
+	}
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+}
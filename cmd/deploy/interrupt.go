@@ -0,0 +1,93 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/deployment"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/pterm/pterm"
+)
+
+const (
+	interruptDetach   = "detach"
+	interruptCancel   = "cancel"
+	interruptRollback = "rollback"
+)
+
+// errDetached is returned when the user leaves a deployment running, so that
+// the projects that depend on it are not deployed before it finishes
+var errDetached = errors.New("detached from the deployment")
+
+// handleInterrupt asks what to do with deployments the user stopped
+// watching: leave them running, cancel them, or roll back
+func handleInterrupt(client *api.ClientWithResponses, ids []uuid.UUID, rollback func() error, canRollback bool) error {
+	options := []huh.Option[string]{
+		huh.NewOption("Detach and keep deploying", interruptDetach),
+		huh.NewOption("Cancel the deployment", interruptCancel),
+	}
+	if canRollback {
+		options = append(options, huh.NewOption("Roll back to the previous version", interruptRollback))
+	}
+
+	action := interruptDetach
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("What do you want to do with the deployment?").
+				Options(options...).
+				Value(&action),
+		),
+	)
+	if err := form.Run(); err != nil {
+		// A second interrupt leaves the deployment running
+		printDetached(ids)
+		return fmt.Errorf("deployment interrupted")
+	}
+
+	switch action {
+	case interruptCancel:
+		if err := cancelDeployments(client, ids); err != nil {
+			return err
+		}
+		return fmt.Errorf("deployment cancelled")
+	case interruptRollback:
+		if err := cancelDeployments(client, ids); err != nil {
+			return err
+		}
+		fmt.Println()
+		return rollback()
+	default:
+		printDetached(ids)
+		return errDetached
+	}
+}
+
+func cancelDeployments(client *api.ClientWithResponses, ids []uuid.UUID) error {
+	for _, id := range ids {
+		state, err := deployment.Cancel(context.Background(), client, id)
+		if errors.Is(err, deployment.ErrAlreadyFinished) {
+			pterm.Info.Printf("Deployment %s already finished\n", id)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		pterm.Printf("%s Deployment %s %s\n", pterm.Yellow("⚠️"), pterm.Bold.Sprint(id), state)
+	}
+
+	return nil
+}
+
+// printDetached explains how to follow deployments the CLI no longer watches
+func printDetached(ids []uuid.UUID) {
+	fmt.Println("The deployment continues in the background. Follow it with:")
+	for _, id := range ids {
+		fmt.Println(color.CyanString("  portway deployments status %s --wait", id))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,107 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/config"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+// targetOptions selects the environment a rollout command operates on
+type targetOptions struct {
+	configPath  string
+	projectName string
+	envName     string
+}
+
+func addTargetFlags(cmd *cobra.Command, opts *targetOptions) {
+	cmd.Flags().StringVarP(&opts.configPath, "config", "c", ".portway.yaml", "Config file to use")
+	cmd.Flags().StringVarP(&opts.projectName, "project", "p", "", "Project the environment belongs to")
+	cmd.Flags().StringVarP(&opts.envName, "env", "e", "production", "Environment of the rollout")
+}
+
+func NewPromoteCmd() *cobra.Command {
+	var opts targetOptions
+	var weight int
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "promote",
+		Short: "Promote a paused canary rollout",
+		Long: `Promote the canary rollout of an environment to its next step, or to the
+weight given with --weight.
+
+Examples:
+  portway deploy promote
+  portway deploy promote --env staging --weight 100
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if weight < 0 || weight > 100 {
+				return fmt.Errorf("--weight must be between 1 and 100")
+			}
+
+			t, err := config.ResolveTarget(opts.configPath, opts.projectName, opts.envName)
+			if err != nil {
+				return err
+			}
+
+			var target *int
+			if weight > 0 {
+				target = &weight
+			}
+
+			rollout, err := promoteCanary(t.Client, t.OrgSlug, t.ProjectSlug, t.EnvName, target)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println()
+			spinner, done := startDeploySpinner(fmt.Sprintf("Routing %d%% of traffic to the canary", rollout.Weight))
+			failed, err := waitForDeployments(t.Client, spinner, done, []uuid.UUID{rollout.DeploymentId}, timeout)
+			if err != nil {
+				return err
+			}
+			if failed != nil {
+				ExitSpinner(spinner, color.RedString("Canary step failed."))
+				fmt.Println()
+				printHealthOrError(t.Client, failed.ID)
+				fmt.Println()
+				fmt.Printf("Roll back with %s\n", color.CyanString("portway deploy abort --env %s", t.EnvName))
+				return fmt.Errorf("canary step failed")
+			}
+			ExitSpinner(spinner, "Canary step deployed.")
+			fmt.Println()
+
+			printHealthOrError(t.Client, rollout.DeploymentId)
+			fmt.Println()
+
+			printCanaryStatus(rollout)
+
+			return nil
+		},
+	}
+
+	addTargetFlags(cmd, &opts)
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait for the canary step to deploy")
+	cmd.Flags().IntVar(&weight, "weight", 0, "Percentage of traffic to route to the canary (default: the next step)")
+
+	return cmd
+}
+
+func printCanaryStatus(rollout *api.CanaryRollout) {
+	if rollout.Weight >= 100 {
+		pterm.Printf("✅ Canary %s promoted to all traffic\n", pterm.Cyan(rollout.Version))
+		fmt.Println()
+		return
+	}
+
+	pterm.Printf("🐤 Canary %s receives %s of traffic\n", pterm.Cyan(rollout.Version), pterm.Bold.Sprintf("%d%%", rollout.Weight))
+	printCanaryPaused(rollout.Weight)
+}
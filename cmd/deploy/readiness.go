@@ -0,0 +1,135 @@
+package deploy
+
+import (
+	"cli/pkg/compose"
+	"cli/pkg/config"
+	"cli/pkg/probe"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/fatih/color"
+	"github.com/pterm/pterm"
+)
+
+// Exit codes returned by deploy, so scripts can tell failures apart
+const (
+	exitCodeFailed   = 1
+	exitCodeNotReady = 3
+)
+
+// certificateExpiryWarning is how close to expiry a certificate gets flagged
+const certificateExpiryWarning = 14 * 24 * time.Hour
+
+// readinessOptions resolves the readiness probe from the compose x-portway
+// extension, overridden by the environment in .portway.yaml and by flags.
+// It returns nil when the probe is disabled.
+func readinessOptions(env *config.Environment, composeConfig *types.Project, timeout time.Duration) (*probe.Options, error) {
+	ext, err := compose.GetPortwayExtension(composeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := probe.Settings{}.Merge(ext.Readiness).Merge(env.Readiness)
+	if settings.Disabled {
+		return nil, nil
+	}
+
+	opts, err := settings.Resolve()
+	if err != nil {
+		return nil, err
+	}
+
+	if timeout > 0 {
+		opts.Timeout = timeout
+	}
+
+	return opts, nil
+}
+
+// waitForReadiness probes the application until it is ready, showing each
+// new failure reason under a spinner.
+func waitForReadiness(baseURL string, opts *probe.Options) (*probe.Result, error) {
+	spinner := NewSpinnerWithText("Waiting for the application to become ready")
+	go func() {
+		_, _ = spinner.Run()
+	}()
+
+	lastReason := ""
+	result, err := probe.WaitUntilReady(context.Background(), baseURL, opts, func(r *probe.Result) {
+		reason := r.Reason(opts)
+		if reason == lastReason {
+			return
+		}
+		lastReason = reason
+		spinner.Send(logMsg{
+			timestamp: time.Now(),
+			log:       fmt.Sprintf("%s: %s", r.URL, reason),
+			stream:    "stdout",
+		})
+	})
+
+	if err != nil {
+		ExitSpinner(spinner, color.RedString("Application did not become ready."))
+	} else {
+		ExitSpinner(spinner, "Application is ready.")
+	}
+
+	return result, err
+}
+
+func printReadinessReport(result *probe.Result, opts *probe.Options) {
+	if result == nil {
+		return
+	}
+
+	fmt.Println()
+	tableData := pterm.TableData{{"Check", "Result"}}
+	tableData = append(tableData, []string{"URL", result.URL})
+
+	status := pterm.Gray("-")
+	if result.StatusCode != 0 {
+		status = fmt.Sprintf("%d", result.StatusCode)
+		if result.Ready(opts) {
+			status = pterm.Green(status)
+		} else {
+			status = pterm.Red(status)
+		}
+	}
+	tableData = append(tableData, []string{"Status", status})
+	tableData = append(tableData, []string{"Latency", result.Latency.Round(time.Millisecond).String()})
+
+	if opts.BodyMatch != nil && result.Err == nil {
+		matched := pterm.Green("matched")
+		if !result.BodyMatched {
+			matched = pterm.Red("no match")
+		}
+		tableData = append(tableData, []string{"Body", fmt.Sprintf("%s %s", matched, pterm.Gray(opts.BodyMatch.String()))})
+	}
+
+	if result.TLSVersion != "" {
+		tableData = append(tableData, []string{"TLS", result.TLSVersion})
+
+		chain := make([]string, 0, len(result.Chain))
+		for _, cert := range result.Chain {
+			chain = append(chain, cert.Subject)
+		}
+		tableData = append(tableData, []string{"Chain", strings.Join(chain, " ← ")})
+
+		expiresIn := result.ExpiresIn()
+		expiry := fmt.Sprintf("%s (in %d days)", result.Chain[0].NotAfter.Local().Format("2006-01-02"), int(expiresIn.Hours()/24))
+		if expiresIn < certificateExpiryWarning {
+			expiry = pterm.Yellow(expiry)
+		}
+		tableData = append(tableData, []string{"Certificate expires", expiry})
+	}
+
+	if result.Err != nil {
+		tableData = append(tableData, []string{"Error", pterm.Red(result.Reason(opts))})
+	}
+
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+	fmt.Println()
+}
@@ -0,0 +1,210 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/config"
+	"cli/pkg/probe"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/pterm/pterm"
+)
+
+// exitCodeRolledBack is returned when a deployment failed and the previous
+// version was restored
+const exitCodeRolledBack = 4
+
+// defaultMinHealthScore is the health score below which a deployment is
+// rolled back when no threshold is configured
+const defaultMinHealthScore = 50
+
+// rollbackPlan describes how to recover a failed deployment
+type rollbackPlan struct {
+	auto           bool
+	minHealthScore float32
+	previous       *api.EnvironmentComposeFile
+	readiness      *probe.Options
+	deployURL      string
+	timeout        time.Duration
+}
+
+// autoRollbackSettingsOrDefault resolves auto-rollback from the environment
+// config and flags, with the default minimum health score
+func autoRollbackSettingsOrDefault(env *config.Environment, opts deployOptions) config.AutoRollback {
+	settings := config.AutoRollback{MinHealthScore: defaultMinHealthScore}
+	if env.AutoRollback != nil {
+		settings.Enabled = env.AutoRollback.Enabled
+		if env.AutoRollback.MinHealthScore > 0 {
+			settings.MinHealthScore = env.AutoRollback.MinHealthScore
+		}
+	}
+
+	if opts.autoRollback {
+		settings.Enabled = true
+	}
+	if opts.minHealthScore > 0 {
+		settings.MinHealthScore = opts.minHealthScore
+	}
+
+	return settings
+}
+
+// getDeployedComposeFile returns the compose file currently deployed to the
+// environment, or nil if nothing was deployed yet
+func getDeployedComposeFile(ctx context.Context, client *api.ClientWithResponses, orgSlug, projectSlug, envName string) (*api.EnvironmentComposeFile, error) {
+	resp, err := client.GetDeployedEnvironmentComposeFileWithResponse(ctx, orgSlug, projectSlug, envName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployed compose file: %w", err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		return resp.JSON200, nil
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("failed to get deployed compose file: %s", resp.Status())
+	}
+}
+
+// lowestHealthScore returns the deployment with the lowest health score
+func lowestHealthScore(client *api.ClientWithResponses, ids []uuid.UUID) (uuid.UUID, float32, error) {
+	var lowestId uuid.UUID
+	var lowest float32 = 101
+
+	for _, id := range ids {
+		health, err := client.GetDeploymentHealthWithResponse(context.Background(), id)
+		if err != nil {
+			return lowestId, 0, fmt.Errorf("failed to get deployment health: %w", err)
+		}
+		if health.StatusCode() != http.StatusOK || health.JSON200 == nil {
+			return lowestId, 0, fmt.Errorf("failed to get deployment health: %s", health.Status())
+		}
+
+		if health.JSON200.HealthScore < lowest {
+			lowestId = id
+			lowest = health.JSON200.HealthScore
+		}
+	}
+
+	return lowestId, lowest, nil
+}
+
+// checkHealthScore reports whether all deployments reach the minimum health
+// score, printing the health of the worst one when they do not
+func checkHealthScore(client *api.ClientWithResponses, ids []uuid.UUID, minHealthScore float32) (bool, error) {
+	id, score, err := lowestHealthScore(client, ids)
+	if err != nil {
+		return false, err
+	}
+
+	if score >= minHealthScore {
+		return true, nil
+	}
+
+	pterm.Printf("%s Health score %s is below the minimum of %s\n",
+		pterm.Red("❌"),
+		pterm.Red(fmt.Sprintf("%.0f", score)),
+		pterm.Cyan(fmt.Sprintf("%.0f", minHealthScore)),
+	)
+	fmt.Println()
+	printHealthOrError(client, id)
+	fmt.Println()
+
+	return false, nil
+}
+
+// rollback redeploys the previous compose file after the deployment of
+// failedVersion failed. Once the previous version is healthy again it returns
+// an exitError with exitCodeRolledBack, and a plain error when it cannot be
+// restored.
+func rollback(client *api.ClientWithResponses, plan *rollbackPlan, failedVersion string, reason string) error {
+	if plan.previous == nil {
+		fmt.Println(color.YellowString("No previously deployed version to roll back to."))
+		fmt.Println()
+		return fmt.Errorf("%s", reason)
+	}
+
+	restoredVersion := plan.previous.Version
+	pterm.Printf("↩️  Rolling back to version %s\n", pterm.Cyan(restoredVersion))
+	fmt.Println()
+
+	restoreErr := restore(client, plan)
+
+	tableData := pterm.TableData{
+		{"Version", "Result"},
+		{failedVersion, pterm.Red("failed: " + reason)},
+	}
+	if restoreErr != nil {
+		tableData = append(tableData, []string{restoredVersion, pterm.Red("rollback failed: " + restoreErr.Error())})
+	} else {
+		tableData = append(tableData, []string{restoredVersion, pterm.Green("restored")})
+	}
+	fmt.Println()
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+	fmt.Println()
+
+	if restoreErr != nil {
+		return fmt.Errorf("deployment of version %s failed and rollback to version %s failed: %w", failedVersion, restoredVersion, restoreErr)
+	}
+
+	fmt.Println(color.YellowString("Deployment of version %s failed. Version %s was restored.", failedVersion, restoredVersion))
+	fmt.Println()
+	return &exitError{code: exitCodeRolledBack, err: fmt.Errorf("deployment of version %s failed, version %s was restored", failedVersion, restoredVersion)}
+}
+
+// restore deploys the previous compose file and waits for it to be healthy
+func restore(client *api.ClientWithResponses, plan *rollbackPlan) error {
+	deployResponse, err := client.DeployEnvironmentComposeFileWithResponse(
+		context.Background(),
+		plan.previous.Id,
+		api.DeployEnvironmentComposeFileJSONRequestBody{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to deploy environment compose file: %w", err)
+	}
+	if deployResponse.StatusCode() != http.StatusOK || deployResponse.JSON200 == nil {
+		return fmt.Errorf("failed to deploy environment compose file: %s", deployResponse.Status())
+	}
+
+	ids := deploymentIds(deployResponse)
+	if len(ids) == 0 {
+		return fmt.Errorf("no deployment targets found")
+	}
+
+	spinner, done := startDeploySpinner("Rolling back")
+	failed, err := waitForDeployments(client, spinner, done, ids, plan.timeout)
+	if err != nil {
+		return err
+	}
+	if failed != nil {
+		ExitSpinner(spinner, color.RedString("Rollback deployment failed."))
+		fmt.Println()
+		printHealthOrError(client, failed.ID)
+		return fmt.Errorf("deployment %s", failed.State)
+	}
+	ExitSpinner(spinner, "Rollback deployed.")
+	fmt.Println()
+
+	healthy, err := checkHealthScore(client, ids, plan.minHealthScore)
+	if err != nil {
+		return err
+	}
+	if !healthy {
+		return fmt.Errorf("health score below %.0f", plan.minHealthScore)
+	}
+
+	if plan.readiness != nil {
+		result, err := waitForReadiness(plan.deployURL, plan.readiness)
+		printReadinessReport(result, plan.readiness)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
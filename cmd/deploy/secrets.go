@@ -0,0 +1,113 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/secrets"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/fatih/color"
+	"github.com/pterm/pterm"
+)
+
+// checkSecrets refuses to upload literal credentials and secrets read from
+// the local machine, and makes sure every external secret referenced by the
+// compose file exists in the environment. Literal credentials are also
+// reported by the PW050 check, but the lint policy cannot turn this block
+// off.
+func checkSecrets(
+	ctx context.Context,
+	client *api.ClientWithResponses,
+	orgSlug string,
+	projectSlug string,
+	envName string,
+	composeConfig *types.Project,
+) error {
+	findings := secrets.ScanProject(composeConfig)
+	if len(findings) > 0 {
+		fmt.Println()
+		fmt.Printf("%s  Found %d value(s) that look like credentials:\n\n", color.RedString("❌"), len(findings))
+
+		tableData := pterm.TableData{{"Service", "Variable", "Value", "Reason"}}
+		for _, finding := range findings {
+			tableData = append(tableData, []string{
+				pterm.Bold.Sprint(finding.Service),
+				finding.Key,
+				pterm.Gray(secrets.Mask(finding.Value)),
+				finding.Reason,
+			})
+		}
+		pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+		fmt.Println()
+		fmt.Printf("%s Store them with %s and reference them as external compose secrets.\n",
+			color.GreenString("💡"),
+			color.CyanString("portway secrets set <name> --env %s", envName),
+		)
+		fmt.Println()
+		return fmt.Errorf("refusing to upload %d plaintext credential(s)", len(findings))
+	}
+
+	local := secrets.LocalSecrets(composeConfig)
+	if len(local) > 0 {
+		names := make([]string, 0, len(local))
+		for name := range local {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fmt.Println()
+		fmt.Printf("%s  These secrets are read from your machine and will not exist once deployed:\n\n", color.RedString("❌"))
+		for _, name := range names {
+			fmt.Printf("   - %s (%s)\n", color.New(color.Bold).Sprint(name), local[name])
+		}
+		fmt.Println()
+		fmt.Printf("%s Store them with %s and mark them %s in the compose file.\n",
+			color.GreenString("💡"),
+			color.CyanString("portway secrets set <name> --env %s", envName),
+			color.CyanString("external: true"),
+		)
+		fmt.Println()
+		return fmt.Errorf("compose file uses %d local secret(s)", len(local))
+	}
+
+	external := secrets.ExternalSecrets(composeConfig)
+	if len(external) == 0 {
+		return nil
+	}
+
+	response, err := client.ListEnvironmentSecretsWithResponse(ctx, orgSlug, projectSlug, envName)
+	if err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	}
+	if response.StatusCode() != 200 || response.JSON200 == nil {
+		return fmt.Errorf("failed to list secrets: %s", response.Status())
+	}
+
+	existing := map[string]bool{}
+	for _, secret := range response.JSON200.Secrets {
+		existing[secret.Name] = true
+	}
+
+	var missing []string
+	for _, name := range external {
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		fmt.Println()
+		fmt.Printf("%s  Missing secrets in %s: %s\n", color.RedString("❌"), color.CyanString(envName), strings.Join(missing, ", "))
+		fmt.Printf("%s Set them with %s\n", color.GreenString("💡"), color.CyanString("portway secrets set <name> --env %s", envName))
+		fmt.Println()
+		return fmt.Errorf("%d secret(s) referenced by the compose file are not set", len(missing))
+	}
+
+	pterm.Printf("🔐 %d secret(s) resolved from %s\n", len(external), pterm.Cyan(envName))
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package deploy
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/deployment"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+)
+
+// errInterrupted is returned when the user interrupts the spinner
+var errInterrupted = errors.New("operation interrupted by user")
+
+// startDeploySpinner runs a spinner in the background. The returned channel
+// receives an error when the user interrupts it.
+func startDeploySpinner(text string) (*tea.Program, <-chan error) {
+	spinner := NewSpinnerWithText(text)
+
+	// Channel to signal spinner completion/interruption
+	done := make(chan error, 1)
+
+	go func() {
+		// Run spinner in background and capture if it was interrupted
+		model, err := spinner.Run()
+		if err != nil {
+			done <- err
+			return
+		}
+
+		// Check if the spinner was quitting (possibly due to Ctrl+C)
+		if spinnerModel, ok := model.(spinnerModel); ok && spinnerModel.quitting {
+			done <- errInterrupted
+			return
+		}
+
+		done <- nil
+	}()
+
+	return spinner, done
+}
+
+// waitForDeployments streams the logs of each deployment to the spinner
+// until all of them are deployed. It returns the first deployment that did
+// not succeed, leaving the spinner running so the caller can report it.
+func waitForDeployments(client *api.ClientWithResponses, spinner *tea.Program, done <-chan error, ids []uuid.UUID, timeout time.Duration) (*deployment.Deployment, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Stop polling when the spinner is interrupted
+	interrupted := make(chan error, 1)
+	go func() {
+		select {
+		case err := <-done:
+			if err != nil {
+				interrupted <- err
+				cancel()
+			}
+		case <-ctx.Done():
+		}
+	}()
+
+	watcher := deployment.NewWatcher(client, deployment.Options{
+		Timeout: timeout,
+		OnEvent: func(event deployment.Event) {
+			switch event.Type {
+			case deployment.EventLog:
+				spinner.Send(logMsg{
+					timestamp: event.Log.Timestamp,
+					log:       event.Log.Message,
+					stream:    event.Log.Stream,
+				})
+			case deployment.EventRetry:
+				spinner.Send(logMsg{
+					timestamp: time.Now(),
+					log:       fmt.Sprintf("Retrying: %s", event.Err),
+					stream:    "stderr",
+				})
+			case deployment.EventUnknownState:
+				spinner.Send(logMsg{
+					timestamp: time.Now(),
+					log:       fmt.Sprintf("Waiting: %s", event.Err),
+					stream:    "stderr",
+				})
+			}
+		},
+	})
+
+	last, err := watcher.WaitAll(ctx, ids)
+	if err != nil {
+		select {
+		case err := <-interrupted:
+			fmt.Println()
+			fmt.Println(color.RedString("Deployment interrupted."))
+			fmt.Println()
+			return nil, err
+		default:
+		}
+
+		ExitSpinner(spinner, color.RedString("Deployment failed."))
+		fmt.Println()
+		return nil, err
+	}
+
+	if last != nil && !last.State.Succeeded() {
+		return last, nil
+	}
+
+	return nil, nil
+}
+
+// failureMessage describes a deployment that did not succeed
+func failureMessage(d *deployment.Deployment) string {
+	if d.State == deployment.StateCancelled {
+		return "The deployment was cancelled."
+	}
+	return "An error happened while trying to deploy your application."
+}
+
+// deploymentIds returns the ids of the deployments created by a deploy request
+func deploymentIds(response *api.DeployEnvironmentComposeFileResponse) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(response.JSON200.Deployments))
+	for _, d := range response.JSON200.Deployments {
+		if d.Id != nil {
+			ids = append(ids, *d.Id)
+		}
+	}
+	return ids
+}
+
+// printHealthOrError prints the health of a deployment, or why it could not
+func printHealthOrError(client *api.ClientWithResponses, deploymentId uuid.UUID) {
+	if err := printHealth(client, deploymentId); err != nil {
+		fmt.Println()
+		fmt.Println(color.RedString("Failed to print health."))
+		fmt.Println(color.RedString(err.Error()))
+		fmt.Println()
+	}
+}
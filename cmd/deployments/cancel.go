@@ -0,0 +1,74 @@
+package deployments
+
+import (
+	"cli/pkg/deployment"
+	"errors"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewCancelCmd() *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:   "cancel <deployment-id>",
+		Short: "Cancel an in-flight deployment",
+		Long: `Cancel an in-flight deployment. The environment keeps running the previously
+deployed version.
+
+Examples:
+  portway deployments cancel 3f6c2a1e-...
+  portway deployments cancel 3f6c2a1e-... --yes
+`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseDeploymentID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed := false
+				form := huh.NewForm(
+					huh.NewGroup(
+						huh.NewConfirm().
+							Title(fmt.Sprintf("Cancel deployment %s?", id)).
+							Value(&confirmed),
+					),
+				)
+				if err := form.Run(); err != nil {
+					return fmt.Errorf("failed to run prompt: %w", err)
+				}
+				if !confirmed {
+					return nil
+				}
+			}
+
+			state, err := deployment.Cancel(cmd.Context(), client, id)
+			if errors.Is(err, deployment.ErrAlreadyFinished) {
+				pterm.Info.Printf("Deployment %s already finished\n", id)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			pterm.Success.Printf("Deployment %s %s\n", pterm.Bold.Sprint(id), stateColor(state))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation")
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package deployments
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/deployment"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+func NewDeploymentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployments",
+		Short: "Deployments commands",
+		Long: `Commands for inspecting deployments.
+
+The deployment ID is printed by portway deploy when a deployment starts.`,
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewLogsCmd())
+	cmd.AddCommand(NewStatusCmd())
+	cmd.AddCommand(NewCancelCmd())
+	cmd.AddCommand(NewListCmd())
+	cmd.AddCommand(NewDescribeCmd())
+	cmd.AddCommand(NewDiffCmd())
+
+	return cmd
+}
+
+func newClient() (*api.ClientWithResponses, error) {
+	client, err := api.NewViperClientWithResponses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return client, nil
+}
+
+func parseDeploymentID(arg string) (uuid.UUID, error) {
+	id, err := uuid.Parse(arg)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid deployment ID %q", arg)
+	}
+	return id, nil
+}
+
+func stateColor(state deployment.State) string {
+	switch {
+	case state.Succeeded():
+		return color.GreenString(string(state))
+	case state == deployment.StateFailed:
+		return color.RedString(string(state))
+	case state.Terminal():
+		return color.YellowString(string(state))
+	default:
+		return color.CyanString(string(state))
+	}
+}
+
+func getComposeFile(ctx context.Context, client *api.ClientWithResponses, id uuid.UUID) (*api.EnvironmentComposeFile, error) {
+	response, err := client.GetEnvironmentComposeFileWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compose file: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		return response.JSON200, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("compose file %s not found", id)
+	default:
+		return nil, fmt.Errorf("failed to get compose file: %s", response.Status())
+	}
+}
+
+// getDeployment fetches a deployment along with the compose file it deployed
+func getDeployment(ctx context.Context, client *api.ClientWithResponses, id uuid.UUID) (*deployment.Deployment, *api.EnvironmentComposeFile, error) {
+	d, err := deployment.NewWatcher(client, deployment.Options{}).Get(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	composeFile, err := getComposeFile(ctx, client, d.ComposeFileID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return d, composeFile, nil
+}
+
+// formatDuration rounds a duration for display
+func formatDuration(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Minute / 6).String()
+}
@@ -0,0 +1,134 @@
+package deployments
+
+import (
+	"cli/pkg/api"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewDescribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe <deployment-id>",
+		Short: "Show the details of a deployment",
+		Long: `Show the compose version, images and health of a deployment.
+
+Examples:
+  portway deployments describe 3f6c2a1e-...
+`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseDeploymentID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			d, composeFile, err := getDeployment(cmd.Context(), client, id)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println()
+			pterm.DefaultTable.WithData(pterm.TableData{
+				{pterm.Bold.Sprint("ID"), d.ID.String()},
+				{pterm.Bold.Sprint("Status"), stateColor(d.State)},
+				{pterm.Bold.Sprint("Version"), composeFile.Version},
+				{pterm.Bold.Sprint("Compose File"), composeFile.Id.String()},
+				{pterm.Bold.Sprint("Created"), d.CreatedAt.Local().Format(time.DateTime)},
+				{pterm.Bold.Sprint("Updated"), d.UpdatedAt.Local().Format(time.DateTime)},
+			}).Render()
+
+			fmt.Println()
+			imagesTableData := pterm.TableData{{"Service", "Image"}}
+			for _, service := range composeImages(composeFile.Compose) {
+				imagesTableData = append(imagesTableData, []string{service[0], pterm.Cyan(service[1])})
+			}
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(imagesTableData).Render()
+
+			fmt.Println()
+			return printHealth(cmd, client, d.ID)
+		},
+	}
+
+	return cmd
+}
+
+// composeImages returns the service names and images of a normalized
+// compose file, sorted by service
+func composeImages(compose map[string]any) [][2]string {
+	services, _ := compose["services"].(map[string]any)
+
+	images := make([][2]string, 0, len(services))
+	for name, service := range services {
+		image := pterm.Gray("(no image)")
+		if s, ok := service.(map[string]any); ok {
+			if value, ok := s["image"].(string); ok && value != "" {
+				image = value
+			}
+		}
+		images = append(images, [2]string{name, image})
+	}
+
+	sort.Slice(images, func(i, j int) bool {
+		return images[i][0] < images[j][0]
+	})
+
+	return images
+}
+
+func printHealth(cmd *cobra.Command, client *api.ClientWithResponses, id uuid.UUID) error {
+	health, err := client.GetDeploymentHealthWithResponse(cmd.Context(), id)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment health: %w", err)
+	}
+	if health.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to get deployment health: %s", health.Status())
+	}
+
+	info := health.JSON200
+	pterm.Printf("Health score %s\n", pterm.Bold.Sprintf("%.0f", info.HealthScore))
+	fmt.Println(info.Summary)
+
+	if info.Health == nil || info.Health.Pods == nil || len(*info.Health.Pods) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	podTableData := pterm.TableData{{"Pod", "Phase", "Restarts"}}
+	for _, pod := range *info.Health.Pods {
+		podTableData = append(podTableData, []string{
+			stringValue(pod.Name),
+			stringValue(pod.Phase),
+			fmt.Sprintf("%d", int(float32Value(pod.Restarts))),
+		})
+	}
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(podTableData).Render()
+	fmt.Println()
+
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func float32Value(f *float32) float32 {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
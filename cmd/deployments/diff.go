@@ -0,0 +1,69 @@
+package deployments
+
+import (
+	"cli/pkg/textdiff"
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func NewDiffCmd() *cobra.Command {
+	var context int
+
+	cmd := &cobra.Command{
+		Use:   "diff <deployment-a> <deployment-b>",
+		Short: "Show the compose changes between two deployments",
+		Long: `Show a unified diff of the compose files deployed by two deployments.
+
+Examples:
+  portway deployments diff 3f6c2a1e-... 9b1d7c40-...
+`,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idA, err := parseDeploymentID(args[0])
+			if err != nil {
+				return err
+			}
+			idB, err := parseDeploymentID(args[1])
+			if err != nil {
+				return err
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			_, a, err := getDeployment(cmd.Context(), client, idA)
+			if err != nil {
+				return err
+			}
+			_, b, err := getDeployment(cmd.Context(), client, idB)
+			if err != nil {
+				return err
+			}
+
+			diff := textdiff.Unified(
+				fmt.Sprintf("%s (%s)", a.Version, idA),
+				fmt.Sprintf("%s (%s)", b.Version, idB),
+				a.RawCompose,
+				b.RawCompose,
+				context,
+			)
+			if diff == "" {
+				fmt.Printf("No compose changes between %s and %s\n", color.CyanString(a.Version), color.CyanString(b.Version))
+				return nil
+			}
+
+			fmt.Print(textdiff.Colorize(diff))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVarP(&context, "context", "U", textdiff.DefaultContext, "Number of unchanged lines to show around each change")
+
+	return cmd
+}
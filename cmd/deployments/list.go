@@ -0,0 +1,108 @@
+package deployments
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/config"
+	"cli/pkg/deployment"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewListCmd() *cobra.Command {
+	var configPath string
+	var projectName string
+	var envName string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the deployments of an environment",
+		Long: `List the deployments of an environment, most recent first.
+
+Examples:
+  portway deployments list
+  portway deployments list --env staging --limit 50
+`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := config.ResolveTarget(configPath, projectName, envName)
+			if err != nil {
+				return err
+			}
+
+			response, err := t.Client.ListEnvironmentDeploymentsWithResponse(
+				cmd.Context(),
+				t.OrgSlug,
+				t.ProjectSlug,
+				t.EnvName,
+				&api.ListEnvironmentDeploymentsParams{Limit: &limit},
+			)
+			if err != nil {
+				return fmt.Errorf("failed to list deployments: %w", err)
+			}
+			if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+				return fmt.Errorf("failed to list deployments: %s", response.Status())
+			}
+
+			deployments := *response.JSON200
+			if len(deployments) == 0 {
+				pterm.Info.Printf("No deployments in %s yet\n", t.EnvName)
+				return nil
+			}
+
+			fmt.Println()
+			tableData := pterm.TableData{{"ID", "Version", "Status", "Created", "Updated", "Duration", "Triggered By"}}
+			for _, d := range deployments {
+				tableData = append(tableData, []string{
+					d.Id.String(),
+					d.Version,
+					stateColor(deployment.State(d.Status)),
+					d.CreatedAt.Local().Format(time.DateTime),
+					d.UpdatedAt.Local().Format(time.DateTime),
+					deploymentDuration(d),
+					triggeredBy(d),
+				})
+			}
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+			fmt.Println()
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file to use")
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project the environment belongs to")
+	cmd.Flags().StringVarP(&envName, "env", "e", "production", "Environment to list deployments for")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 20, "Maximum number of deployments to show")
+
+	return cmd
+}
+
+func deploymentDuration(d api.DeploymentSummary) string {
+	switch {
+	case d.FinishedAt != nil:
+		return formatDuration(d.FinishedAt.Sub(d.CreatedAt))
+	case deployment.State(d.Status).Terminal():
+		return formatDuration(d.UpdatedAt.Sub(d.CreatedAt))
+	default:
+		return pterm.Gray(formatDuration(time.Since(d.CreatedAt)) + " so far")
+	}
+}
+
+func triggeredBy(d api.DeploymentSummary) string {
+	if d.TriggeredBy == nil {
+		return pterm.Gray("-")
+	}
+	if d.TriggeredBy.Name != nil && *d.TriggeredBy.Name != "" {
+		return *d.TriggeredBy.Name
+	}
+	if d.TriggeredBy.Email != nil {
+		return *d.TriggeredBy.Email
+	}
+	return pterm.Gray("-")
+}
@@ -0,0 +1,93 @@
+package deployments
+
+import (
+	"cli/pkg/deployment"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+func NewLogsCmd() *cobra.Command {
+	var follow bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "logs <deployment-id>",
+		Short: "Show the logs of a deployment",
+		Long: `Show the logs of a deployment. With --follow, new logs are printed until the
+deployment finishes.
+
+Examples:
+  portway deployments logs 3f6c2a1e-...
+  portway deployments logs 3f6c2a1e-... --follow
+`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseDeploymentID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			if !follow {
+				watcher := deployment.NewWatcher(client, deployment.Options{})
+				d, err := watcher.Get(cmd.Context(), id)
+				if err != nil {
+					return err
+				}
+				for _, log := range d.Logs {
+					printLog(log)
+				}
+				return nil
+			}
+
+			watcher := deployment.NewWatcher(client, deployment.Options{
+				Timeout: timeout,
+				OnEvent: func(event deployment.Event) {
+					switch event.Type {
+					case deployment.EventLog:
+						printLog(event.Log)
+					case deployment.EventRetry:
+						fmt.Println(color.YellowString("Retrying: %s", event.Err))
+					case deployment.EventUnknownState:
+						fmt.Println(color.YellowString("Waiting: %s", event.Err))
+					}
+				},
+			})
+
+			d, err := watcher.Wait(cmd.Context(), id)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println()
+			fmt.Printf("Deployment %s\n", stateColor(d.State))
+			if !d.State.Succeeded() {
+				return fmt.Errorf("deployment %s", d.State)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Follow the logs until the deployment finishes")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to follow the logs")
+
+	return cmd
+}
+
+func printLog(log deployment.Log) {
+	timestamp := color.New(color.Faint).Sprint(log.Timestamp.Local().Format(time.TimeOnly))
+	if log.Stream == "stdout" {
+		fmt.Printf("%s %s\n", timestamp, log.Message)
+	} else {
+		fmt.Printf("%s %s\n", timestamp, color.RedString(log.Message))
+	}
+}
@@ -0,0 +1,89 @@
+package deployments
+
+import (
+	"cli/pkg/deployment"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewStatusCmd() *cobra.Command {
+	var wait bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "status <deployment-id>",
+		Short: "Show the status of a deployment",
+		Long: `Show the status of a deployment. With --wait, the command waits until the
+deployment finishes and exits non-zero when it did not succeed.
+
+Examples:
+  portway deployments status 3f6c2a1e-...
+  portway deployments status 3f6c2a1e-... --wait --timeout 10m
+`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseDeploymentID(args[0])
+			if err != nil {
+				return err
+			}
+
+			client, err := newClient()
+			if err != nil {
+				return err
+			}
+
+			watcher := deployment.NewWatcher(client, deployment.Options{
+				Timeout: timeout,
+				OnEvent: func(event deployment.Event) {
+					if !wait {
+						return
+					}
+					switch event.Type {
+					case deployment.EventState:
+						fmt.Printf("%s %s\n", pterm.Gray(time.Now().Format(time.TimeOnly)), stateColor(event.State))
+					case deployment.EventUnknownState:
+						fmt.Printf("%s %s\n", pterm.Yellow("⚠️"), event.Err)
+					}
+				},
+			})
+
+			var d *deployment.Deployment
+			if wait {
+				d, err = watcher.Wait(cmd.Context(), id)
+				fmt.Println()
+			} else {
+				d, err = watcher.Get(cmd.Context(), id)
+			}
+			if err != nil {
+				return err
+			}
+
+			tableData := pterm.TableData{
+				{"ID", "State", "Created", "Updated", "Logs"},
+				{
+					d.ID.String(),
+					stateColor(d.State),
+					d.CreatedAt.Local().Format(time.DateTime),
+					d.UpdatedAt.Local().Format(time.DateTime),
+					fmt.Sprintf("%d", len(d.Logs)),
+				},
+			}
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+			if wait && !d.State.Succeeded() {
+				return fmt.Errorf("deployment %s", d.State)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "Wait until the deployment finishes")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Minute, "How long to wait with --wait")
+
+	return cmd
+}
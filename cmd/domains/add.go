@@ -0,0 +1,63 @@
+package domains
+
+import (
+	"slices"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewAddCmd(opts *targetOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <domain>",
+		Short: "Add a custom domain",
+		Long: `Add a custom domain to an environment and print the DNS records it needs.
+
+Examples:
+  portway domains add app.example.com --env production`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hostname, err := normalizeHostname(args[0])
+			if err != nil {
+				return err
+			}
+
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			if slices.Contains(t.Environment.Domains, hostname) {
+				pterm.Info.Printf("%s is already configured for %s\n", hostname, t.EnvName)
+			} else {
+				t.Environment.Domains = append(t.Environment.Domains, hostname)
+			}
+
+			domains, err := syncDomains(cmd, t)
+			if err != nil {
+				return err
+			}
+
+			pterm.Success.Printf("Added %s to %s\n", pterm.Bold.Sprint(hostname), pterm.Cyan(t.EnvName))
+
+			for _, domain := range domains {
+				if domain.Hostname != hostname {
+					continue
+				}
+
+				pterm.Println()
+				pterm.Println("Create these DNS records with your DNS provider:")
+				pterm.Println()
+				printRecords(domain)
+			}
+
+			pterm.Println()
+			pterm.Printf("%s Once the records are in place, run %s\n", pterm.Green("💡"), pterm.Gray("portway domains verify "+hostname+" --env "+t.EnvName))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
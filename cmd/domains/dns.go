@@ -0,0 +1,88 @@
+package domains
+
+import (
+	"cli/pkg/api"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/pterm/pterm"
+)
+
+// checkRecord resolves a DNS record locally and reports whether it matches
+func checkRecord(record api.DnsRecord) (bool, string) {
+	name := strings.TrimSuffix(record.Name, ".")
+	expected := strings.TrimSuffix(strings.ToLower(record.Value), ".")
+
+	switch record.Type {
+	case api.CNAME:
+		cname, err := net.LookupCNAME(name)
+		if err != nil {
+			return false, "not found"
+		}
+		actual := strings.TrimSuffix(strings.ToLower(cname), ".")
+		if actual != expected {
+			return false, "points to " + actual
+		}
+		return true, ""
+
+	case api.TXT:
+		values, err := net.LookupTXT(name)
+		if err != nil {
+			return false, "not found"
+		}
+		if !slices.Contains(values, record.Value) {
+			return false, "value does not match"
+		}
+		return true, ""
+
+	case api.A:
+		addrs, err := net.LookupHost(name)
+		if err != nil {
+			return false, "not found"
+		}
+		if !slices.Contains(addrs, record.Value) {
+			return false, "resolves to " + strings.Join(addrs, ", ")
+		}
+		return true, ""
+	}
+
+	return false, "unsupported record type"
+}
+
+// printRecords prints the DNS records a domain needs, along with the result
+// of resolving each of them from this machine. It returns whether all of
+// them resolved as expected.
+func printRecords(domain api.Domain) bool {
+	allValid := true
+
+	tableData := pterm.TableData{{"Type", "Name", "Value", "Local DNS"}}
+	for _, record := range domain.Records {
+		valid, reason := checkRecord(record)
+		status := pterm.Green("✓")
+		if !valid {
+			allValid = false
+			status = pterm.Red("✗ " + reason)
+		}
+		tableData = append(tableData, []string{
+			pterm.Bold.Sprint(string(record.Type)),
+			record.Name,
+			pterm.Cyan(record.Value),
+			status,
+		})
+	}
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+	return allValid
+}
+
+func statusColor(status string) string {
+	switch status {
+	case "verified", "issued":
+		return pterm.Green(status)
+	case "failed":
+		return pterm.Red(status)
+	default:
+		return pterm.Yellow(status)
+	}
+}
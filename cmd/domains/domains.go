@@ -0,0 +1,83 @@
+package domains
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/config"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var hostnamePattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// targetOptions selects the environment a domains command operates on
+type targetOptions struct {
+	configPath  string
+	projectName string
+	envName     string
+}
+
+func NewDomainsCmd() *cobra.Command {
+	var opts targetOptions
+
+	cmd := &cobra.Command{
+		Use:   "domains",
+		Short: "Custom domains commands",
+		Long: `Commands for managing the custom domains of an environment.
+
+Domains are stored under the environment's domains key in .portway.yaml and
+synced to Portway. Once the DNS records printed by 'domains add' are in
+place, run 'domains verify' to check them and wait for the certificate.`,
+		SilenceUsage: true,
+	}
+
+	cmd.PersistentFlags().StringVarP(&opts.configPath, "config", "c", ".portway.yaml", "Config file to use")
+	cmd.PersistentFlags().StringVarP(&opts.projectName, "project", "p", "", "Project the environment belongs to")
+	cmd.PersistentFlags().StringVarP(&opts.envName, "env", "e", "production", "Environment to manage domains for")
+
+	cmd.AddCommand(NewAddCmd(&opts))
+	cmd.AddCommand(NewRemoveCmd(&opts))
+	cmd.AddCommand(NewListCmd(&opts))
+	cmd.AddCommand(NewVerifyCmd(&opts))
+
+	return cmd
+}
+
+func resolveTarget(opts *targetOptions) (*config.Target, error) {
+	return config.ResolveTarget(opts.configPath, opts.projectName, opts.envName)
+}
+
+func normalizeHostname(hostname string) (string, error) {
+	hostname = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+	if !hostnamePattern.MatchString(hostname) {
+		return "", fmt.Errorf("invalid domain: %s", hostname)
+	}
+	return hostname, nil
+}
+
+// syncDomains pushes the environment domains to the server, then writes them
+// to the config. The config is left as is when the sync fails.
+func syncDomains(cmd *cobra.Command, t *config.Target) ([]api.Domain, error) {
+	domains := t.Environment.Domains
+	if domains == nil {
+		domains = []string{}
+	}
+
+	response, err := t.Client.SyncEnvironmentDomainsWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName, api.SyncEnvironmentDomainsJSONRequestBody{
+		Domains: domains,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync domains: %w", err)
+	}
+	if response.StatusCode() != 200 || response.JSON200 == nil {
+		return nil, fmt.Errorf("failed to sync domains: %s", response.Status())
+	}
+
+	if err := t.Config.SaveDomains(t.ProjectSlug, t.EnvName); err != nil {
+		return nil, fmt.Errorf("failed to save config: %w", err)
+	}
+
+	return response.JSON200.Domains, nil
+}
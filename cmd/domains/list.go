@@ -0,0 +1,84 @@
+package domains
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewListCmd(opts *targetOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List custom domains",
+		Long:         "List the custom domains of an environment with their verification and certificate status",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			response, err := t.Client.ListEnvironmentDomainsWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName)
+			if err != nil {
+				return fmt.Errorf("failed to list domains: %w", err)
+			}
+			if response.StatusCode() != 200 || response.JSON200 == nil {
+				return fmt.Errorf("failed to list domains: %s", response.Status())
+			}
+
+			domains := response.JSON200.Domains
+			sort.Slice(domains, func(i, j int) bool {
+				return domains[i].Hostname < domains[j].Hostname
+			})
+
+			if len(domains) == 0 && len(t.Environment.Domains) == 0 {
+				pterm.Info.Printf("No custom domains configured for %s\n", t.EnvName)
+				return nil
+			}
+
+			remote := map[string]bool{}
+			tableData := pterm.TableData{{"Domain", "Status", "Certificate", "Config"}}
+			for _, domain := range domains {
+				remote[domain.Hostname] = true
+				inConfig := pterm.Green("✓")
+				if !slices.Contains(t.Environment.Domains, domain.Hostname) {
+					inConfig = pterm.Yellow("missing")
+				}
+				tableData = append(tableData, []string{
+					pterm.Bold.Sprint(domain.Hostname),
+					statusColor(string(domain.Status)),
+					statusColor(string(domain.CertificateStatus)),
+					inConfig,
+				})
+			}
+
+			outOfSync := false
+			for _, hostname := range t.Environment.Domains {
+				if remote[hostname] {
+					continue
+				}
+				outOfSync = true
+				tableData = append(tableData, []string{
+					pterm.Bold.Sprint(hostname),
+					pterm.Gray("not synced"),
+					pterm.Gray("-"),
+					pterm.Green("✓"),
+				})
+			}
+
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+			if outOfSync {
+				pterm.Println()
+				pterm.Printf("%s Some domains in the config are not synced. Run %s for each of them.\n", pterm.Yellow("⚠️"), pterm.Gray("portway domains add <domain>"))
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+package domains
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewRemoveCmd(opts *targetOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "remove <domain>",
+		Short:        "Remove a custom domain",
+		Long:         "Remove a custom domain from an environment. Traffic to the domain stops being routed to the environment.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hostname, err := normalizeHostname(args[0])
+			if err != nil {
+				return err
+			}
+
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			index := slices.Index(t.Environment.Domains, hostname)
+			if index < 0 {
+				return fmt.Errorf("%s is not configured for %s", hostname, t.EnvName)
+			}
+			t.Environment.Domains = slices.Delete(t.Environment.Domains, index, index+1)
+
+			if _, err := syncDomains(cmd, t); err != nil {
+				return err
+			}
+
+			pterm.Success.Printf("Removed %s from %s\n", pterm.Bold.Sprint(hostname), pterm.Cyan(t.EnvName))
+			pterm.Printf("%s You can now delete its DNS records\n", pterm.Gray("ℹ️"))
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package domains
+
+import (
+	"cli/pkg/probe"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewVerifyCmd(opts *targetOptions) *cobra.Command {
+	var wait bool
+	var timeout time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "verify [domain]",
+		Short: "Verify custom domains",
+		Long: `Check the DNS records of custom domains and wait for their certificates.
+
+Without a domain, every domain of the environment is verified.
+
+Examples:
+  portway domains verify --env production
+  portway domains verify app.example.com --timeout 15m`,
+		Args:         cobra.MaximumNArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			hostnames := t.Environment.Domains
+			if len(args) == 1 {
+				hostname, err := normalizeHostname(args[0])
+				if err != nil {
+					return err
+				}
+				hostnames = []string{hostname}
+			}
+
+			if len(hostnames) == 0 {
+				pterm.Info.Printf("No custom domains configured for %s\n", t.EnvName)
+				return nil
+			}
+
+			failed := 0
+			for _, hostname := range hostnames {
+				pterm.Println()
+				pterm.Printf("🌐 %s\n\n", pterm.Bold.Sprint(hostname))
+
+				response, err := t.Client.VerifyEnvironmentDomainWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName, hostname)
+				if err != nil {
+					return fmt.Errorf("failed to verify %s: %w", hostname, err)
+				}
+				if response.StatusCode() == 404 {
+					pterm.Printf("%s Not synced. Run %s first.\n", pterm.Red("❌"), pterm.Gray("portway domains add "+hostname))
+					failed++
+					continue
+				}
+				if response.StatusCode() != 200 || response.JSON200 == nil {
+					return fmt.Errorf("failed to verify %s: %s", hostname, response.Status())
+				}
+
+				domain := response.JSON200
+				dnsValid := printRecords(*domain)
+				pterm.Println()
+				pterm.Printf("Status: %s  Certificate: %s\n", statusColor(string(domain.Status)), statusColor(string(domain.CertificateStatus)))
+				if domain.Message != nil && *domain.Message != "" {
+					pterm.Printf("%s\n", pterm.Gray(*domain.Message))
+				}
+
+				if string(domain.Status) != "verified" {
+					if dnsValid {
+						pterm.Printf("%s Records resolve locally, Portway may still see cached DNS. Try again in a few minutes.\n", pterm.Yellow("⚠️"))
+					} else {
+						pterm.Printf("%s Fix the records marked ✗ with your DNS provider. DNS changes can take a while to propagate.\n", pterm.Yellow("💡"))
+					}
+					failed++
+					continue
+				}
+
+				if !wait {
+					continue
+				}
+
+				ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+				url := "https://" + hostname
+				err = probe.WaitForCertificate(ctx, url, 5*time.Second, func(err error) {
+					if probe.IsCertificateError(err) {
+						fmt.Printf("Waiting for SSL certificate to be valid at %s ...\n", url)
+						return
+					}
+					fmt.Printf("Waiting for %s to respond ...\n", url)
+				})
+				cancel()
+				if err != nil {
+					if probe.IsCertificateError(err) {
+						pterm.Printf("%s Certificate for %s was not issued within %s\n", pterm.Red("❌"), hostname, timeout)
+					} else {
+						pterm.Printf("%s %s did not respond within %s: %v\n", pterm.Red("❌"), url, timeout, err)
+					}
+					failed++
+					continue
+				}
+
+				pterm.Printf("%s %s is live\n", pterm.Green("✅"), pterm.Cyan(url))
+			}
+
+			pterm.Println()
+			if failed > 0 {
+				return fmt.Errorf("%d domain(s) are not ready", failed)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&wait, "wait", true, "Wait for the certificate to be issued once DNS is verified")
+	cmd.Flags().DurationVar(&timeout, "timeout", 10*time.Minute, "How long to wait for each certificate")
+
+	return cmd
+}
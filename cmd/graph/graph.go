@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"cli/pkg/compose"
+	"cli/pkg/compose/graph"
+	"cli/pkg/config"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewGraphCmd() *cobra.Command {
+	var composeFile string
+	var configPath string
+	var projectName string
+	var envName string
+	var envFiles []string
+	var format string
+	var output string
+	var networks bool
+
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Export the dependency graph of the compose services",
+		Long: `Export the graph of the compose services as DOT, Mermaid or JSON.
+
+Edges point from a service to the services it depends on or links to, with
+the depends_on condition when it is not service_started. Dependencies on
+services that share no network and on undefined services are drawn in red.
+With --networks, DOT and Mermaid also draw the networks each service is
+attached to; JSON always lists them.
+
+${VAR} references are interpolated as deploy does, from the environment
+selected with --env, from --env-file and from interpolation.allow-env.
+
+Render DOT with Graphviz, e.g. portway graph | dot -Tsvg -o services.svg.
+Cycles, undefined dependencies and orphan services are reported by
+portway validate.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !slices.Contains(graph.Formats, format) {
+				return fmt.Errorf("unsupported format %q, expected one of %s", format, strings.Join(graph.Formats, ", "))
+			}
+
+			if composeFile == "" {
+				composeFile = compose.FindComposeFile(".")
+			}
+			if composeFile == "" {
+				return fmt.Errorf("no compose file found - specify one with -f flag")
+			}
+
+			loadOpts := compose.LoadOptions{EnvFiles: envFiles}
+			cfg, err := config.LoadConfig(configPath)
+			switch {
+			case err == nil:
+				loadOpts, err = cfg.LoadOptions(projectName, envName, envFiles)
+				if err != nil {
+					return err
+				}
+			case !errors.Is(err, os.ErrNotExist) || cmd.Flags().Changed("config"):
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			project, err := graph.Load([]string{composeFile}, loadOpts)
+			if err != nil {
+				return fmt.Errorf("failed to load compose config: %w", err)
+			}
+			g := graph.New(project)
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("failed to create %s: %w", output, err)
+				}
+				defer file.Close()
+				w = file
+			}
+
+			if err := graph.Write(w, format, g, networks); err != nil {
+				return fmt.Errorf("failed to write graph: %w", err)
+			}
+
+			if g.Broken() {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s The graph has cycles or undefined dependencies, run %s for details\n", pterm.Yellow("⚠️"), pterm.Cyan("portway validate"))
+			}
+			if output != "" {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s Wrote %s graph to %s\n", pterm.Green("✅"), format, output)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&composeFile, "file", "f", "", "Docker Compose file to read")
+	cmd.Flags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file with the interpolation values of the environments")
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project whose environment provides the interpolation values")
+	cmd.Flags().StringVarP(&envName, "env", "e", "production", "Environment whose env-files and variables are interpolated")
+	cmd.Flags().StringSliceVar(&envFiles, "env-file", nil, "Env file used for ${VAR} interpolation in compose files (can be repeated)")
+	cmd.Flags().StringVar(&format, "format", graph.FormatDOT, "Output format ("+strings.Join(graph.Formats, ", ")+")")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write the graph to a file instead of stdout")
+	cmd.Flags().BoolVar(&networks, "networks", false, "Draw the networks of the services (dot and mermaid)")
+
+	return cmd
+}
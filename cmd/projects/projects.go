@@ -0,0 +1,100 @@
+package projects
+
+import (
+	"cli/pkg/config"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewProjectsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "projects",
+		Short:        "Projects commands",
+		Long:         "Commands for inspecting the projects declared in the Portway config",
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(NewListCmd())
+
+	return cmd
+}
+
+func NewListCmd() *cobra.Command {
+	var configPath string
+
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List configured projects",
+		Long:         "List the projects declared in the config file, in the order they are deployed with --all-projects",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.LoadConfig(configPath)
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			if len(cfg.Projects) == 0 {
+				pterm.Info.Println("No projects configured")
+				return nil
+			}
+
+			order, err := cfg.ProjectDeployOrder()
+			if err != nil {
+				return err
+			}
+
+			defaultProject := cfg.GetProjectSlug()
+
+			tableData := pterm.TableData{{"#", "Project", "Default", "Environments", "Depends On"}}
+			for i, name := range order {
+				project := cfg.Projects[name]
+
+				environments := make([]string, 0, len(project.Environments))
+				for envName := range project.Environments {
+					if envName == project.DefaultEnvironment {
+						envName += "*"
+					}
+					environments = append(environments, envName)
+				}
+				sort.Strings(environments)
+
+				isDefault := ""
+				if name == defaultProject {
+					isDefault = pterm.Green("✓")
+				}
+
+				dependsOn := pterm.Gray("-")
+				if len(project.DependsOn) > 0 {
+					dependsOn = strings.Join(project.DependsOn, ", ")
+				}
+
+				tableData = append(tableData, []string{
+					fmt.Sprintf("%d", i+1),
+					pterm.Bold.Sprint(name),
+					isDefault,
+					strings.Join(environments, ", "),
+					dependsOn,
+				})
+			}
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+			pterm.Println()
+			pterm.Printf("%s Default environments are marked with *\n", pterm.Gray("ℹ️"))
+			pterm.Printf("%s Deploy one project with %s or all of them with %s\n",
+				pterm.Green("💡"),
+				pterm.Gray("portway deploy --project <name>"),
+				pterm.Gray("portway deploy --all-projects"),
+			)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file to read")
+
+	return cmd
+}
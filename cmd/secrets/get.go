@@ -0,0 +1,51 @@
+package secrets
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewGetCmd(opts *targetOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "get <name>",
+		Short:        "Show a secret",
+		Long:         "Show the metadata of a secret. The value itself is never printed.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			response, err := t.Client.GetEnvironmentSecretWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to get secret: %w", err)
+			}
+			if response.StatusCode() == 404 {
+				return fmt.Errorf("secret %s not found in %s", args[0], t.EnvName)
+			}
+			if response.StatusCode() != 200 || response.JSON200 == nil {
+				return fmt.Errorf("failed to get secret: %s", response.Status())
+			}
+
+			secret := response.JSON200
+			pterm.Printf("Name:        %s\n", pterm.Bold.Sprint(secret.Name))
+			pterm.Printf("Environment: %s\n", pterm.Cyan(t.EnvName))
+			pterm.Printf("Version:     %d\n", secret.Version)
+			pterm.Printf("Value:       %s\n", pterm.Gray("(hidden)"))
+			pterm.Printf("Created:     %s\n", secret.CreatedAt.Local().Format(time.RFC1123))
+			pterm.Printf("Updated:     %s\n", secret.UpdatedAt.Local().Format(time.RFC1123))
+			if secret.UpdatedBy != nil {
+				pterm.Printf("Updated by:  %s\n", *secret.UpdatedBy)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewListCmd(opts *targetOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "List secrets",
+		Long:         "List the secrets of an environment. Values are never printed.",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			response, err := t.Client.ListEnvironmentSecretsWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName)
+			if err != nil {
+				return fmt.Errorf("failed to list secrets: %w", err)
+			}
+			if response.StatusCode() != 200 || response.JSON200 == nil {
+				return fmt.Errorf("failed to list secrets: %s", response.Status())
+			}
+
+			secrets := response.JSON200.Secrets
+			if len(secrets) == 0 {
+				pterm.Info.Printf("No secrets set in %s\n", t.EnvName)
+				return nil
+			}
+
+			sort.Slice(secrets, func(i, j int) bool {
+				return secrets[i].Name < secrets[j].Name
+			})
+
+			tableData := pterm.TableData{{"Name", "Version", "Updated", "Updated By"}}
+			for _, secret := range secrets {
+				updatedBy := pterm.Gray("-")
+				if secret.UpdatedBy != nil {
+					updatedBy = *secret.UpdatedBy
+				}
+				tableData = append(tableData, []string{
+					pterm.Bold.Sprint(secret.Name),
+					fmt.Sprintf("%d", secret.Version),
+					secret.UpdatedAt.Local().Format(time.RFC3339),
+					updatedBy,
+				})
+			}
+			pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+
+			return nil
+		},
+	}
+
+	return cmd
+}
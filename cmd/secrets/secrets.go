@@ -0,0 +1,55 @@
+package secrets
+
+import (
+	"cli/pkg/config"
+
+	"github.com/spf13/cobra"
+)
+
+// targetOptions selects the environment a secrets command operates on
+type targetOptions struct {
+	configPath  string
+	projectName string
+	envName     string
+}
+
+func NewSecretsCmd() *cobra.Command {
+	var opts targetOptions
+
+	cmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Secrets commands",
+		Long: `Commands for managing the secrets of an environment.
+
+Secret values are encrypted before they leave your machine and are stored
+server-side. They are never printed by the CLI.
+
+Reference a secret from your compose file with an external top-level secret:
+
+  services:
+    api:
+      secrets:
+        - stripe_key
+
+  secrets:
+    stripe_key:
+      external: true
+`,
+		SilenceUsage: true,
+	}
+
+	cmd.PersistentFlags().StringVarP(&opts.configPath, "config", "c", ".portway.yaml", "Config file to use")
+	cmd.PersistentFlags().StringVarP(&opts.projectName, "project", "p", "", "Project the environment belongs to")
+	cmd.PersistentFlags().StringVarP(&opts.envName, "env", "e", "production", "Environment to manage secrets for")
+
+	cmd.AddCommand(NewSetCmd(&opts))
+	cmd.AddCommand(NewGetCmd(&opts))
+	cmd.AddCommand(NewListCmd(&opts))
+	cmd.AddCommand(NewUnsetCmd(&opts))
+
+	return cmd
+}
+
+func resolveTarget(opts *targetOptions) (*config.Target, error) {
+	return config.ResolveTarget(opts.configPath, opts.projectName, opts.envName)
+}
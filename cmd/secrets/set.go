@@ -0,0 +1,117 @@
+package secrets
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/secrets"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewSetCmd(opts *targetOptions) *cobra.Command {
+	var fromStdin bool
+	var fromFile string
+
+	cmd := &cobra.Command{
+		Use:   "set <name>",
+		Short: "Create or update a secret",
+		Long: `Create or update a secret in an environment.
+
+The value is read from a hidden prompt, from standard input with --stdin, or
+from a file with --from-file. It is never accepted as a command line argument
+so it does not end up in your shell history.
+
+Examples:
+  portway secrets set DATABASE_URL --env production
+  cat key.pem | portway secrets set TLS_KEY --stdin
+  portway secrets set SERVICE_ACCOUNT --from-file service-account.json`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			if fromStdin && fromFile != "" {
+				return fmt.Errorf("--stdin and --from-file cannot be used together")
+			}
+
+			var value []byte
+			switch {
+			case fromStdin:
+				data, err := io.ReadAll(os.Stdin)
+				if err != nil {
+					return fmt.Errorf("failed to read value from stdin: %w", err)
+				}
+				value = []byte(strings.TrimRight(string(data), "\r\n"))
+			case fromFile != "":
+				data, err := os.ReadFile(fromFile)
+				if err != nil {
+					return fmt.Errorf("failed to read value from file: %w", err)
+				}
+				value = data
+			default:
+				var input string
+				form := huh.NewForm(
+					huh.NewGroup(
+						huh.NewInput().
+							Title(fmt.Sprintf("Value for %s", name)).
+							EchoMode(huh.EchoModePassword).
+							Value(&input),
+					),
+				)
+				if err := form.Run(); err != nil {
+					return fmt.Errorf("failed to read value: %w", err)
+				}
+				value = []byte(input)
+			}
+
+			if len(value) == 0 {
+				return fmt.Errorf("secret value cannot be empty")
+			}
+
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			publicKey, err := t.Client.GetEnvironmentSecretsPublicKeyWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName)
+			if err != nil {
+				return fmt.Errorf("failed to get public key: %w", err)
+			}
+			if publicKey.StatusCode() != 200 || publicKey.JSON200 == nil {
+				return fmt.Errorf("failed to get public key: %s", publicKey.Status())
+			}
+
+			encrypted, err := secrets.Encrypt(publicKey.JSON200.Key, value)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt secret: %w", err)
+			}
+
+			response, err := t.Client.SetEnvironmentSecretWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName, name, api.SetEnvironmentSecretJSONRequestBody{
+				KeyId:        publicKey.JSON200.KeyId,
+				EncryptedKey: encrypted.EncryptedKey,
+				Nonce:        encrypted.Nonce,
+				Ciphertext:   encrypted.Ciphertext,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to set secret: %w", err)
+			}
+			if response.StatusCode() != 200 || response.JSON200 == nil {
+				return fmt.Errorf("failed to set secret: %s", response.Status())
+			}
+
+			pterm.Success.Printf("Secret %s set in %s (version %d)\n", pterm.Bold.Sprint(name), pterm.Cyan(t.EnvName), response.JSON200.Version)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&fromStdin, "stdin", false, "Read the value from standard input")
+	cmd.Flags().StringVar(&fromFile, "from-file", "", "Read the value from a file")
+
+	return cmd
+}
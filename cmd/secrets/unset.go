@@ -0,0 +1,65 @@
+package secrets
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/pterm/pterm"
+	"github.com/spf13/cobra"
+)
+
+func NewUnsetCmd(opts *targetOptions) *cobra.Command {
+	var yes bool
+
+	cmd := &cobra.Command{
+		Use:          "unset <name>",
+		Short:        "Delete a secret",
+		Long:         "Delete a secret from an environment. Services referencing it will fail to start on their next deployment.",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			t, err := resolveTarget(opts)
+			if err != nil {
+				return err
+			}
+
+			if !yes {
+				confirmed := false
+				form := huh.NewForm(
+					huh.NewGroup(
+						huh.NewConfirm().
+							Title(fmt.Sprintf("Delete secret %s from %s?", name, t.EnvName)).
+							Value(&confirmed),
+					),
+				)
+				if err := form.Run(); err != nil {
+					return fmt.Errorf("failed to run prompt: %w", err)
+				}
+				if !confirmed {
+					return nil
+				}
+			}
+
+			response, err := t.Client.DeleteEnvironmentSecretWithResponse(cmd.Context(), t.OrgSlug, t.ProjectSlug, t.EnvName, name)
+			if err != nil {
+				return fmt.Errorf("failed to delete secret: %w", err)
+			}
+			if response.StatusCode() == 404 {
+				return fmt.Errorf("secret %s not found in %s", name, t.EnvName)
+			}
+			if response.StatusCode() != 204 && response.StatusCode() != 200 {
+				return fmt.Errorf("failed to delete secret: %s", response.Status())
+			}
+
+			pterm.Success.Printf("Secret %s deleted from %s\n", pterm.Bold.Sprint(name), pterm.Cyan(t.EnvName))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Do not ask for confirmation")
+
+	return cmd
+}
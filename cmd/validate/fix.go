@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"cli/pkg/compose/lint"
+	"cli/pkg/textdiff"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pterm/pterm"
+)
+
+// applyFixes writes the fixed compose files, or with dryRun prints the
+// changes as a unified diff
+func applyFixes(fixed []lint.FixedFile, dryRun bool) error {
+	if len(fixed) == 0 {
+		pterm.Printf("\n%s No issues with an automatic fix found\n", pterm.Blue("ℹ️"))
+		return nil
+	}
+
+	for _, file := range fixed {
+		name := displayName(file.Path)
+
+		if dryRun {
+			fmt.Println()
+			fmt.Print(textdiff.Colorize(textdiff.Unified(name, name, string(file.Original), string(file.Fixed), textdiff.DefaultContext)))
+			continue
+		}
+
+		info, err := os.Stat(file.Path)
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", file.Path, err)
+		}
+		if err := os.WriteFile(file.Path, file.Fixed, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.Path, err)
+		}
+	}
+
+	fmt.Println()
+	for _, file := range fixed {
+		verb := "Fixed"
+		if dryRun {
+			verb = "Would fix"
+		}
+		pterm.Printf("%s %s %d issue(s) in %s\n", pterm.Green("✅"), verb, len(file.Issues), pterm.Cyan(displayName(file.Path)))
+		for _, issue := range file.Issues {
+			pterm.Printf("   %s %s\n", pterm.Cyan(issue.Code), issue.Field)
+		}
+	}
+
+	return nil
+}
+
+// displayName returns path relative to the working directory when inside it
+func displayName(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || !filepath.IsLocal(rel) {
+		return path
+	}
+	return rel
+}
@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"cli/pkg/compose/lint"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyFixes(t *testing.T) {
+	const (
+		original = "services:\n  web:\n    image: nginx:1.27\n    privileged: true\n"
+		fixed    = "services:\n  web:\n    image: nginx:1.27\n"
+	)
+
+	tests := []struct {
+		name   string
+		dryRun bool
+		want   string
+	}{
+		{name: "dry run", dryRun: true, want: original},
+		{name: "write", dryRun: false, want: fixed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "compose.yaml")
+			if err := os.WriteFile(path, []byte(original), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			files := []lint.FixedFile{{
+				Path:     path,
+				Original: []byte(original),
+				Fixed:    []byte(fixed),
+				Issues:   []lint.Issue{{Code: "PW001", Service: "web", Field: "services.web.privileged"}},
+			}}
+			if err := applyFixes(files, tt.dryRun); err != nil {
+				t.Fatalf("applyFixes: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("file =\n%s\nwant\n%s", data, tt.want)
+			}
+
+			info, err := os.Stat(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if perm := info.Mode().Perm(); perm != 0o600 {
+				t.Errorf("permissions = %o, want 600 kept", perm)
+			}
+		})
+	}
+}
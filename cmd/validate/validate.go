@@ -1,10 +1,18 @@
 package validate
 
 import (
+	"cli/pkg/api"
 	"cli/pkg/compose"
+	"cli/pkg/compose/graph"
+	"cli/pkg/compose/lint"
+	"cli/pkg/config"
+	"cli/pkg/registry"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/compose-spec/compose-go/v2/types"
@@ -12,98 +20,199 @@ import (
 	"github.com/spf13/cobra"
 )
 
-type ValidationResult struct {
-	Errors   []ValidationIssue
-	Warnings []ValidationIssue
-	Info     []ValidationIssue
-}
-
-type ValidationIssue struct {
-	ValidationCheck *ValidationCheck
-	Service         string
-	Field           string
-	Message         string
-	Suggestion      string
-}
-
-type ValidationCheck struct {
-	Code        string
-	Name        string
-	Description string
-	Severity    string
-	Category    string
-	CheckFunc   func(ctx ValidationContext) []ValidationIssue
-}
-
-type ValidationContext struct {
-	Scope   string // global or service
-	Project *types.Project
-}
-
 func NewValidateCmd() *cobra.Command {
-	var composeFile string
+	var composeFiles []string
+	var projectName string
+	var envName string
+	var envFiles []string
 	var skipChecks []string
+	var remote bool
+	var format string
+	var configPath string
+	var fix bool
+	var checkImages bool
+	var dryRun bool
 
 	cmd := &cobra.Command{
-		Use:          "validate",
-		Short:        "Validate Docker Compose file for Kubernetes deployment",
-		Long:         "Analyze a Docker Compose file and identify potential issues when deploying to Kubernetes clusters",
+		Use:   "validate",
+		Short: "Validate Docker Compose file for Kubernetes deployment",
+		Long: `Analyze a Docker Compose file and identify potential issues when deploying to Kubernetes clusters.
+
+The local checks run offline. With --remote, the checks of the Portway API
+run as well and their results are merged in, as during deploy. Limits are
+then also checked against the plan of the organization. With --check-images,
+images are looked up in their registries.
+
+Repeat -f to validate compose files merged as deploy merges them, e.g. a base
+file and an override. Issues point at the file that sets the value.
+
+${VAR} references are interpolated as deploy does, only from the env-files
+and variables of the environment selected with --env, from --env-file and
+from the shell variables listed in interpolation.allow-env.
+
+Use --format to write the issues as json, sarif, junit or github workflow
+annotations, with the file, line and column of each issue. The command still
+exits non-zero when errors are found.
+
+With --fix, issues that have an automatic fix are fixed in the compose files,
+keeping comments and key order. Add --dry-run to print the changes as a diff
+instead.
+
+The lint section of .portway.yaml overrides check severities, ignores checks
+per service and sets the severity that fails validation with fail-on.
+Services can also ignore checks with x-portway-ignore: [PW003].
+
+Portway settings such as endpoints, domains, autoscaling and health probes
+are set in the x-portway extension, at the top level and per service. They
+are checked against its JSON Schema, which list-checks documents and prints
+with --schema.
+
+Custom rules are listed in lint.rules as files of CEL expressions over the
+project as uploaded to Portway, e.g.
+
+  rules:
+    - code: ACME001
+      name: Team Label
+      severity: error
+      doc-url: https://wiki.example.com/acme001
+      expression: '"team" in service.?labels.orValue({})'
+      message: Service has no team label
+      field: labels
+
+Expressions see service, name and project, and must be true for every
+service, or for the project with scope: project. parseBytes("2G") converts
+sizes for comparisons with memory limits. Custom rules show up in
+list-checks.`,
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if composeFile == "" {
+			if !slices.Contains(lint.Formats, format) {
+				return fmt.Errorf("unsupported format %q, expected one of %s", format, strings.Join(lint.Formats, ", "))
+			}
+			human := format == lint.FormatText
+			if fix && !human {
+				return fmt.Errorf("--fix cannot be used with --format %s", format)
+			}
+			if dryRun && !fix {
+				return fmt.Errorf("--dry-run requires --fix")
+			}
+
+			if len(composeFiles) == 0 {
 				// Look for default compose files in current directory
-				for _, f := range []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"} {
-					if _, err := os.Stat(f); err == nil {
-						composeFile = f
-						break
-					}
+				if composeFile := compose.FindComposeFile("."); composeFile != "" {
+					composeFiles = []string{composeFile}
 				}
 			}
 
-			if composeFile == "" {
+			if len(composeFiles) == 0 {
 				pterm.Printf("%s No compose file found\n", pterm.Red("❌"))
 				return fmt.Errorf("no compose file found - specify one with -f flag")
 			}
 
-			absPath, err := filepath.Abs(composeFile)
+			absPaths := make([]string, 0, len(composeFiles))
+			for _, composeFile := range composeFiles {
+				absPath, err := filepath.Abs(composeFile)
+				if err != nil {
+					return fmt.Errorf("failed to get absolute path: %w", err)
+				}
+				absPaths = append(absPaths, absPath)
+			}
+
+			if human {
+				pterm.Printf("Validating compose file(s): %s\n", pterm.Cyan(strings.Join(absPaths, ", ")))
+
+				// Show skipped checks if any
+				if len(skipChecks) > 0 {
+					pterm.Printf("Skipping checks: %s\n", pterm.Gray(strings.Join(skipChecks, ", ")))
+				}
+			}
+
+			cfg, err := loadConfig(cmd, configPath)
+			if err != nil {
+				return err
+			}
+
+			policy, checks, err := loadPolicy(cfg)
 			if err != nil {
-				return fmt.Errorf("failed to get absolute path: %w", err)
+				return err
 			}
 
-			composeConfig, err := compose.LoadComposeConfig([]string{absPath})
+			loadOpts, err := loadOptions(cfg, projectName, envName, envFiles)
 			if err != nil {
-				return fmt.Errorf("failed to load compose config: %w", err)
+				return err
 			}
 
-			pterm.Printf("Validating compose file: %s\n", pterm.Cyan(absPath))
+			lintOpts := lint.Options{Skip: skipChecks, Policy: policy, Registry: checks}
+			if checkImages {
+				lintOpts.Images = registry.NewClient()
+			}
+			if remote {
+				client, err := api.NewViperClientWithResponses()
+				if err != nil {
+					return fmt.Errorf("failed to create client: %w", err)
+				}
+				lintOpts.Client = client
 
-			// Show skipped checks if any
-			if len(skipChecks) > 0 {
-				pterm.Printf("Skipping checks: %s\n", pterm.Gray(strings.Join(skipChecks, ", ")))
+				lintOpts.Plan, err = lint.FetchPlan(cmd.Context(), client, "")
+				if err != nil {
+					return fmt.Errorf("failed to get plan limits: %w", err)
+				}
 			}
-			pterm.Println()
 
-			// Load and parse the compose file
-			result, err := validateComposeFile(composeConfig, skipChecks)
+			composeConfig, sources, issues, err := lintFiles(cmd, absPaths, loadOpts, lintOpts)
 			if err != nil {
-				pterm.Printf("%s Failed to validate compose file: %v\n", pterm.Red("❌"), err)
 				return err
 			}
 
-			// Display results
-			displayValidationResults(result)
+			if fix {
+				fixed, err := lint.Fix(composeConfig, sources.Files(), issues, checks)
+				if err != nil {
+					return fmt.Errorf("failed to fix compose file: %w", err)
+				}
+
+				if err := applyFixes(fixed, dryRun); err != nil {
+					return err
+				}
 
-			// Return error if there are critical errors
-			if len(result.Errors) > 0 {
-				return fmt.Errorf("validation failed with %d error(s)", len(result.Errors))
+				// Report what is left after the fixes were written
+				if len(fixed) > 0 && !dryRun {
+					_, _, issues, err = lintFiles(cmd, absPaths, loadOpts, lintOpts)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			if !human {
+				report := lint.Report{Files: sources.Files(), Checks: enabledChecks(checks, append(skipChecks, policy.Disabled()...)), Issues: issues}
+				if err := lint.WriteReport(cmd.OutOrStdout(), format, report); err != nil {
+					return fmt.Errorf("failed to write %s report: %w", format, err)
+				}
+				return failure(policy, issues)
+			}
+
+			if len(issues) == 0 {
+				pterm.Println()
+				pterm.Printf("%s No issues found! Your compose file looks good for Kubernetes deployment.\n", pterm.Green("✅"))
+				return nil
 			}
 
-			return nil
+			lint.Display(issues)
+
+			return failure(policy, issues)
 		},
 	}
 
-	cmd.Flags().StringVarP(&composeFile, "file", "f", "", "Docker Compose file to validate")
+	cmd.Flags().StringSliceVarP(&composeFiles, "file", "f", nil, "Docker Compose file to validate (can be repeated, later files override earlier ones)")
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "Project whose environment provides the interpolation values")
+	cmd.Flags().StringVarP(&envName, "env", "e", "production", "Environment whose env-files and variables are interpolated")
+	cmd.Flags().StringSliceVar(&envFiles, "env-file", nil, "Env file used for ${VAR} interpolation in compose files (can be repeated)")
 	cmd.Flags().StringSliceVar(&skipChecks, "skip-checks", []string{}, "Skip specific validation checks (comma-separated list of check codes, e.g. PW001,PW002)")
+	cmd.Flags().BoolVar(&remote, "remote", false, "Also run the checks of the Portway API (requires authentication)")
+	cmd.PersistentFlags().StringVarP(&configPath, "config", "c", ".portway.yaml", "Config file with the lint policy and custom rules")
+	cmd.Flags().StringVar(&format, "format", lint.FormatText, "Output format ("+strings.Join(lint.Formats, ", ")+")")
+	cmd.Flags().BoolVar(&checkImages, "check-images", false, "Look up images in their registries to check they exist, run on linux/amd64 and are not too large")
+	cmd.Flags().BoolVar(&fix, "fix", false, "Rewrite the compose files to fix the issues that have an automatic fix")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "With --fix, show the changes as a diff without writing them")
 
 	// Add subcommand to list all checks
 	cmd.AddCommand(NewListChecksCmd())
@@ -111,200 +220,226 @@ func NewValidateCmd() *cobra.Command {
 	return cmd
 }
 
-func NewListChecksCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:          "list-checks",
-		Short:        "List all available validation checks",
-		Long:         "Display a list of all available validation checks with their codes and descriptions",
-		SilenceUsage: true,
-		RunE: func(cmd *cobra.Command, args []string) error {
-			displayAvailableChecks()
-			return nil
-		},
+// lintFiles loads and merges compose files and lints the result, locating
+// issues in the files it was loaded from
+func lintFiles(cmd *cobra.Command, paths []string, loadOpts compose.LoadOptions, opts lint.Options) (*types.Project, *compose.SourceMap, []lint.Issue, error) {
+	// Cycles and undefined dependencies are reported by the checks
+	composeConfig, err := graph.Load(paths, loadOpts)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load compose config: %w", err)
 	}
 
-	return cmd
-}
-
-func displayAvailableChecks() {
-	pterm.Printf("%s Available Validation Checks\n\n", pterm.Blue("📋"))
-
-	// Create table data
-	tableData := pterm.TableData{
-		{"Code", "Name", "Description", "Category", "Severity"},
+	sources, err := compose.NewSourceMap(paths)
+	if err != nil {
+		return nil, nil, nil, err
 	}
+	opts.Sources = sources
 
-	// Sort checks by code for consistent output
-	var codes []string
-	for code := range checkRegistry.Checks {
-		codes = append(codes, code)
+	issues, err := lint.Run(cmd.Context(), composeConfig, opts)
+	if err != nil {
+		pterm.Printf("%s Failed to validate compose file: %v\n", pterm.Red("❌"), err)
+		return nil, nil, nil, err
 	}
 
-	// Sort the codes
-	for i := 0; i < len(codes); i++ {
-		for j := i + 1; j < len(codes); j++ {
-			if codes[i] > codes[j] {
-				codes[i], codes[j] = codes[j], codes[i]
-			}
-		}
-	}
+	return composeConfig, sources, issues, nil
+}
 
-	for _, code := range codes {
-		check := checkRegistry.Checks[code]
-		var severityColor string
-		if check.Severity == "ERROR" {
-			severityColor = pterm.Red(check.Severity)
-		} else if check.Severity == "INFO" {
-			severityColor = pterm.Blue(check.Severity)
-		} else {
-			severityColor = pterm.Yellow(check.Severity)
+// loadConfig reads the config. A missing config is only an error when
+// --config was set explicitly, otherwise nil is returned.
+func loadConfig(cmd *cobra.Command, configPath string) (*config.Config, error) {
+	cfg, err := config.LoadConfig(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !cmd.Flags().Changed("config") {
+			return nil, nil
 		}
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return cfg, nil
+}
 
-		tableData = append(tableData, []string{
-			pterm.Cyan(check.Code),
-			pterm.Bold.Sprint(check.Name),
-			check.Description,
-			pterm.Gray(check.Category),
-			severityColor,
-		})
+// loadPolicy returns the lint section of the config and the checks with its
+// custom rules
+func loadPolicy(cfg *config.Config) (*lint.Policy, *lint.Registry, error) {
+	if cfg == nil {
+		return nil, lint.DefaultRegistry, nil
 	}
 
-	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
+	if err := cfg.Lint.Validate(); err != nil {
+		return nil, nil, err
+	}
 
-	pterm.Printf("\n%s Usage Examples:\n", pterm.Green("💡"))
-	pterm.Printf("  Skip specific checks: %s\n", pterm.Gray("deploy validate --skip-checks PW003,PW005"))
-	pterm.Printf("  Skip all warnings: %s\n", pterm.Gray("deploy validate --skip-checks "+getWarningCodes()))
-	pterm.Printf("\n%s For detailed documentation: https://docs.portway.dev/validate\n", pterm.Gray("📚"))
+	checks, err := cfg.LintRegistry()
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg.Lint, checks, nil
 }
 
-func getWarningCodes() string {
-	var warningCodes []string
-	for _, check := range checkRegistry.Checks {
-		if check.Severity == "WARNING" {
-			warningCodes = append(warningCodes, check.Code)
-		}
+// loadOptions returns the interpolation sources of the selected environment,
+// the way deploy loads the compose files. Without a config only envFiles are
+// used.
+func loadOptions(cfg *config.Config, projectName, envName string, envFiles []string) (compose.LoadOptions, error) {
+	if cfg == nil {
+		return compose.LoadOptions{EnvFiles: envFiles}, nil
 	}
+	return cfg.LoadOptions(projectName, envName, envFiles)
+}
 
-	// Sort the warning codes
-	for i := 0; i < len(warningCodes); i++ {
-		for j := i + 1; j < len(warningCodes); j++ {
-			if warningCodes[i] > warningCodes[j] {
-				warningCodes[i], warningCodes[j] = warningCodes[j], warningCodes[i]
-			}
-		}
+// failure returns an error when issues reach the fail-on severity
+func failure(policy *lint.Policy, issues []lint.Issue) error {
+	failing := policy.Failing(issues)
+	if len(failing) == 0 {
+		return nil
 	}
 
-	return strings.Join(warningCodes, ",")
+	failOn := lint.SeverityError
+	if policy != nil && policy.FailOn != "" {
+		failOn = policy.FailOn
+	}
+	return fmt.Errorf("validation failed with %d issue(s) at or above %s severity", len(failing), failOn)
 }
 
-func validateComposeFile(composeConfig *types.Project, skipChecks []string) (*ValidationResult, error) {
-	result := &ValidationResult{
-		Errors:   []ValidationIssue{},
-		Warnings: []ValidationIssue{},
-		Info:     []ValidationIssue{},
+// enabledChecks returns the local checks that are not skipped
+func enabledChecks(registry *lint.Registry, skipChecks []string) []lint.Check {
+	var checks []lint.Check
+	for _, check := range registry.All() {
+		if !slices.Contains(skipChecks, check.Code) {
+			checks = append(checks, check)
+		}
 	}
+	return checks
+}
 
-	// Convert skip checks to a map for faster lookup
-	skipMap := make(map[string]bool)
-	for _, check := range skipChecks {
-		skipMap[strings.ToUpper(strings.TrimSpace(check))] = true
-	}
+func NewListChecksCmd() *cobra.Command {
+	var schema bool
 
-	ctx := ValidationContext{Project: composeConfig}
-	for _, check := range checkRegistry.Checks {
-		shouldSkip := skipMap[check.Code]
-		if shouldSkip {
-			continue
-		}
+	cmd := &cobra.Command{
+		Use:   "list-checks",
+		Short: "List all available validation checks",
+		Long: `Display a list of all available validation checks with their codes and descriptions, including the custom rules of the lint policy, and the settings of the x-portway compose extension.
 
-		issues := check.CheckFunc(ctx)
-		for _, issue := range issues {
-			issue.ValidationCheck = &check
-			if check.Severity == "ERROR" {
-				result.Errors = append(result.Errors, issue)
-				continue
+Use --schema to print the JSON Schema of the x-portway extension instead,
+e.g. for editor completion.`,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if schema {
+				_, err := cmd.OutOrStdout().Write(compose.ExtensionSchema)
+				return err
 			}
-			if check.Severity == "INFO" {
-				result.Info = append(result.Info, issue)
-				continue
+
+			configPath, _ := cmd.Flags().GetString("config")
+			cfg, err := loadConfig(cmd, configPath)
+			if err != nil {
+				return err
 			}
-			if check.Severity == "WARNING" {
-				result.Warnings = append(result.Warnings, issue)
-				continue
+
+			_, checks, err := loadPolicy(cfg)
+			if err != nil {
+				return err
 			}
-		}
+
+			return displayAvailableChecks(checks)
+		},
 	}
 
-	return result, nil
+	cmd.Flags().BoolVar(&schema, "schema", false, "Print the JSON Schema of the x-portway compose extension")
+
+	return cmd
 }
 
-func displayValidationResults(result *ValidationResult) {
-	totalIssues := len(result.Errors) + len(result.Warnings) + len(result.Info)
+func displayAvailableChecks(checks *lint.Registry) error {
+	pterm.Printf("%s Available Validation Checks\n\n", pterm.Blue("📋"))
 
-	if totalIssues == 0 {
-		pterm.Printf("%s No issues found! Your compose file looks good for Kubernetes deployment.\n", pterm.Green("✅"))
-		return
+	// Create table data
+	tableData := pterm.TableData{
+		{"Code", "Name", "Description", "Category", "Severity"},
 	}
 
-	// Display summary
-	pterm.Printf("📊 Validation Summary: %s\n\n",
-		pterm.Sprintf("%d issue(s) found", totalIssues))
-
-	// Display errors
-	if len(result.Errors) > 0 {
-		pterm.Printf("%s Critical Issues (%d):\n", pterm.Red("❌"), len(result.Errors))
-		for i, issue := range result.Errors {
-			displayIssue(i+1, issue, "ERROR")
+	var documented []lint.Check
+	for _, check := range checks.All() {
+		if _, builtin := lint.DefaultRegistry.Get(check.Code); !builtin && check.DocURL != "" {
+			documented = append(documented, check)
 		}
-		pterm.Println()
+		tableData = append(tableData, []string{
+			pterm.Cyan(check.Code),
+			pterm.Bold.Sprint(check.Name),
+			check.Description,
+			pterm.Gray(check.Category),
+			lint.SeverityLabel(check.Severity),
+		})
 	}
 
-	// Display warnings
-	if len(result.Warnings) > 0 {
-		pterm.Printf("%s  Warnings (%d):\n", pterm.Yellow("⚠️"), len(result.Warnings))
-		for i, issue := range result.Warnings {
-			displayIssue(i+1, issue, "WARNING")
-		}
-		pterm.Println()
-	}
+	pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
 
-	// Display info messages
-	if len(result.Info) > 0 {
-		pterm.Printf("%s Information (%d):\n", pterm.Blue("ℹ️"), len(result.Info))
-		for i, issue := range result.Info {
-			displayIssue(i+1, issue, "INFO")
+	if len(documented) > 0 {
+		pterm.Printf("\n%s Custom rules:\n", pterm.Blue("🧩"))
+		for _, check := range documented {
+			pterm.Printf("  %s %s\n", pterm.Cyan(check.Code), pterm.Gray(check.DocURL))
 		}
-		pterm.Println()
 	}
 
-	// Summary message
-	if len(result.Errors) > 0 {
-		pterm.Printf("%s Fix critical issues before deploying to Kubernetes.\n", pterm.Red("🚨"))
-	} else if len(result.Warnings) > 0 {
-		pterm.Printf("%s Warnings found, but deployment should work. Review suggestions for better reliability.\n", pterm.Yellow("💡"))
-	} else {
-		pterm.Printf("%s Only informational messages found. Your compose file is ready for deployment.\n", pterm.Green("✅"))
+	if err := displayExtensionSettings(); err != nil {
+		return err
 	}
 
-	// Add reference to documentation
-	pterm.Printf("\n%s For more information about these checks, visit: https://docs.portway.dev/validate\n", pterm.Gray("📚"))
+	pterm.Printf("\n%s Usage Examples:\n", pterm.Green("💡"))
+	pterm.Printf("  Skip specific checks: %s\n", pterm.Gray("deploy validate --skip-checks PW003,PW005"))
+	pterm.Printf("  Skip all warnings: %s\n", pterm.Gray("deploy validate --skip-checks "+getWarningCodes(checks)))
+	pterm.Printf("\n%s For detailed documentation: %s\n", pterm.Gray("📚"), lint.DocsURL)
+	return nil
 }
 
-func displayIssue(index int, issue ValidationIssue, severityType string) {
-	var severityColor string
-	switch severityType {
-	case "ERROR":
-		severityColor = pterm.Red(severityType)
-	case "INFO":
-		severityColor = pterm.Blue(severityType)
-	default:
-		severityColor = pterm.Yellow(severityType)
+// displayExtensionSettings documents the x-portway extension from its schema
+func displayExtensionSettings() error {
+	pterm.Printf("\n%s %s Settings\n", pterm.Blue("⚙️"), compose.PortwayExtensionKey)
+
+	for _, scope := range []struct{ name, title string }{
+		{"project", "Top level"},
+		{"service", "Per service"},
+	} {
+		settings, err := compose.ExtensionSettings(scope.name)
+		if err != nil {
+			return err
+		}
+
+		tableData := pterm.TableData{
+			{"Setting", "Type", "Description"},
+		}
+		for _, setting := range settings {
+			tableData = append(tableData, []string{
+				pterm.Cyan(setting.Key),
+				pterm.Gray(setting.Type),
+				setting.Description,
+			})
+		}
+
+		pterm.Printf("\n%s:\n", pterm.Bold.Sprint(scope.title))
+		pterm.DefaultTable.WithHasHeader().WithHeaderRowSeparator("-").WithData(tableData).Render()
 	}
 
-	pterm.Printf("   %d. [%s] %s %s\n", index, severityColor, pterm.Cyan(issue.ValidationCheck.Code), pterm.Bold.Sprint(issue.Field))
-	pterm.Printf("      %s\n", issue.Message)
-	if issue.Suggestion != "" {
-		pterm.Printf("      %s %s\n", pterm.Green("💡"), pterm.Gray(issue.Suggestion))
+	pterm.Printf("%s Example:\n", pterm.Green("💡"))
+	pterm.Println(pterm.Gray(extensionExample))
+	return nil
+}
+
+const extensionExample = `  services:
+    web:
+      x-portway:
+        endpoints:
+          - port: 8080
+            path: /
+        domains: [app.example.com]
+        autoscaling: {min-replicas: 2, max-replicas: 10, target-cpu: 70}
+        health: {readiness: /ready, liveness: /healthz}`
+
+func getWarningCodes(checks *lint.Registry) string {
+	var warningCodes []string
+	for _, check := range checks.All() {
+		if check.Severity == lint.SeverityWarning {
+			warningCodes = append(warningCodes, check.Code)
+		}
 	}
-	pterm.Println()
+	sort.Strings(warningCodes)
+
+	return strings.Join(warningCodes, ",")
 }
@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"cli/pkg/compose"
+	"cli/pkg/compose/lint"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestLintFilesOverride(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "compose.yaml")
+	override := filepath.Join(dir, "compose.override.yaml")
+	if err := os.WriteFile(base, []byte("services:\n  web:\n    image: nginx:1.27\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(override, []byte("services:\n  web:\n    privileged: true\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(context.Background())
+
+	project, sources, issues, err := lintFiles(cmd, []string{base, override}, compose.LoadOptions{}, lint.Options{})
+	if err != nil {
+		t.Fatalf("lintFiles: %v", err)
+	}
+	if !project.Services["web"].Privileged {
+		t.Error("web is not privileged, want the override merged")
+	}
+	if files := sources.Files(); len(files) != 2 {
+		t.Errorf("source files = %v, want both compose files", files)
+	}
+
+	for _, issue := range issues {
+		if issue.Code != "PW001" {
+			continue
+		}
+		if issue.Position.File != override || issue.Position.Line != 3 {
+			t.Errorf("PW001 at %s, want %s:3", issue.Position, override)
+		}
+		return
+	}
+	t.Errorf("issues = %+v, want PW001 for the privileged override", issues)
+}
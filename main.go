@@ -3,8 +3,13 @@ package main
 import (
 	"cli/cmd/auth"
 	"cli/cmd/deploy"
+	"cli/cmd/deployments"
 	"cli/cmd/doctor"
+	"cli/cmd/domains"
+	"cli/cmd/graph"
 	initcmd "cli/cmd/init"
+	"cli/cmd/projects"
+	"cli/cmd/secrets"
 	"cli/cmd/settings"
 	"cli/cmd/update"
 	"cli/cmd/validate"
@@ -38,6 +43,7 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.PersistentFlags().String("token", "", "API key to use for authentication")
 
 	rootCmd.AddCommand(deploy.NewDeployCmd())
+	rootCmd.AddCommand(deploy.NewDiffCmd())
 	rootCmd.AddCommand(auth.NewAuthCmd())
 	rootCmd.AddCommand(auth.NewLoginCmd())
 	rootCmd.AddCommand(settings.NewSettingsCmd())
@@ -45,7 +51,12 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.AddCommand(versioncmd.NewVersionCmd())
 	rootCmd.AddCommand(doctor.NewDoctorCmd())
 	rootCmd.AddCommand(validate.NewValidateCmd())
+	rootCmd.AddCommand(graph.NewGraphCmd())
 	rootCmd.AddCommand(initcmd.NewInitCmd())
+	rootCmd.AddCommand(projects.NewProjectsCmd())
+	rootCmd.AddCommand(secrets.NewSecretsCmd())
+	rootCmd.AddCommand(domains.NewDomainsCmd())
+	rootCmd.AddCommand(deployments.NewDeploymentsCmd())
 
 	return rootCmd
 }
@@ -18,6 +18,41 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CanaryRolloutStatus.
+const (
+	Aborted     CanaryRolloutStatus = "aborted"
+	Paused      CanaryRolloutStatus = "paused"
+	Progressing CanaryRolloutStatus = "progressing"
+	Promoted    CanaryRolloutStatus = "promoted"
+)
+
+// Defines values for DeployRequestStrategy.
+const (
+	All    DeployRequestStrategy = "all"
+	Canary DeployRequestStrategy = "canary"
+)
+
+// Defines values for DnsRecordType.
+const (
+	A     DnsRecordType = "A"
+	CNAME DnsRecordType = "CNAME"
+	TXT   DnsRecordType = "TXT"
+)
+
+// Defines values for DomainCertificateStatus.
+const (
+	DomainCertificateStatusFailed  DomainCertificateStatus = "failed"
+	DomainCertificateStatusIssued  DomainCertificateStatus = "issued"
+	DomainCertificateStatusPending DomainCertificateStatus = "pending"
+)
+
+// Defines values for DomainStatus.
+const (
+	DomainStatusFailed   DomainStatus = "failed"
+	DomainStatusPending  DomainStatus = "pending"
+	DomainStatusVerified DomainStatus = "verified"
+)
+
 // Defines values for LintingIssueSeverity.
 const (
 	Error   LintingIssueSeverity = "error"
@@ -25,6 +60,40 @@ const (
 	Warning LintingIssueSeverity = "warning"
 )
 
+// CanaryRollout defines model for CanaryRollout.
+type CanaryRollout struct {
+	// DeploymentId The canary deployment ID
+	DeploymentId             openapi_types.UUID `json:"deploymentId"`
+	EnvironmentComposeFileId openapi_types.UUID `json:"environmentComposeFileId"`
+
+	// StableVersion Version serving the remaining traffic
+	StableVersion *string             `json:"stableVersion,omitempty"`
+	Status        CanaryRolloutStatus `json:"status"`
+	Steps         []int               `json:"steps"`
+	UpdatedAt     time.Time           `json:"updatedAt"`
+
+	// Version Version of the compose file being rolled out
+	Version string `json:"version"`
+
+	// Weight Percentage of traffic routed to the canary
+	Weight int `json:"weight"`
+}
+
+// CanaryRolloutStatus defines model for CanaryRollout.Status.
+type CanaryRolloutStatus string
+
+// DeployRequest defines model for DeployRequest.
+type DeployRequest struct {
+	// Steps Percentages of traffic routed to the new version at each canary step. The last step must be 100.
+	Steps *[]int `json:"steps,omitempty"`
+
+	// Strategy Roll out to all traffic at once, or in canary steps
+	Strategy *DeployRequestStrategy `json:"strategy,omitempty"`
+}
+
+// DeployRequestStrategy Roll out to all traffic at once, or in canary steps
+type DeployRequestStrategy string
+
 // Deployment defines model for Deployment.
 type Deployment struct {
 	CreatedAt     time.Time          `json:"createdAt"`
@@ -35,6 +104,58 @@ type Deployment struct {
 	VersionId     openapi_types.UUID `json:"versionId"`
 }
 
+// DeploymentSummary defines model for DeploymentSummary.
+type DeploymentSummary struct {
+	CreatedAt                time.Time          `json:"createdAt"`
+	EnvironmentComposeFileId openapi_types.UUID `json:"environmentComposeFileId"`
+
+	// FinishedAt When the deployment reached a final status
+	FinishedAt *time.Time         `json:"finishedAt,omitempty"`
+	Id         openapi_types.UUID `json:"id"`
+	Status     string             `json:"status"`
+
+	// TriggeredBy User or API key that started the deployment
+	TriggeredBy *struct {
+		Email *string `json:"email,omitempty"`
+		Name  *string `json:"name,omitempty"`
+	} `json:"triggeredBy,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Version Version of the deployed compose file
+	Version string `json:"version"`
+}
+
+// DnsRecord defines model for DnsRecord.
+type DnsRecord struct {
+	Name  string        `json:"name"`
+	Type  DnsRecordType `json:"type"`
+	Value string        `json:"value"`
+}
+
+// DnsRecordType defines model for DnsRecord.Type.
+type DnsRecordType string
+
+// Domain defines model for Domain.
+type Domain struct {
+	CertificateStatus DomainCertificateStatus `json:"certificateStatus"`
+	CreatedAt         time.Time               `json:"createdAt"`
+	Hostname          string                  `json:"hostname"`
+
+	// Message Details about the current status
+	Message *string `json:"message,omitempty"`
+
+	// Records DNS records required to verify and route the domain
+	Records   []DnsRecord  `json:"records"`
+	Status    DomainStatus `json:"status"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// DomainCertificateStatus defines model for Domain.CertificateStatus.
+type DomainCertificateStatus string
+
+// DomainStatus defines model for Domain.Status.
+type DomainStatus string
+
 // Environment defines model for Environment.
 type Environment struct {
 	CreatedAt time.Time          `json:"createdAt"`
@@ -79,6 +200,19 @@ type Organization struct {
 	StripeCustomerId *string                 `json:"stripeCustomerId,omitempty"`
 }
 
+// PlanLimits defines model for PlanLimits.
+type PlanLimits struct {
+	// MaxCpusPerService Maximum CPU limit of a service replica
+	MaxCpusPerService float32 `json:"maxCpusPerService"`
+
+	// MaxMemoryPerServiceBytes Maximum memory limit of a service replica in bytes
+	MaxMemoryPerServiceBytes int64 `json:"maxMemoryPerServiceBytes"`
+
+	// MaxReplicasPerService Maximum number of replicas of a service
+	MaxReplicasPerService int    `json:"maxReplicasPerService"`
+	Plan                  string `json:"plan"`
+}
+
 // Project defines model for Project.
 type Project struct {
 	CreatedAt   time.Time          `json:"createdAt"`
@@ -89,6 +223,22 @@ type Project struct {
 	UpdatedAt   time.Time          `json:"updatedAt"`
 }
 
+// Secret defines model for Secret.
+type Secret struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	UpdatedBy *string   `json:"updatedBy,omitempty"`
+	Version   int       `json:"version"`
+}
+
+// SecretPublicKey defines model for SecretPublicKey.
+type SecretPublicKey struct {
+	// Key PEM encoded RSA public key used to encrypt secret values
+	Key   string `json:"key"`
+	KeyId string `json:"keyId"`
+}
+
 // ZodError defines model for ZodError.
 type ZodError struct {
 	Errors []struct {
@@ -116,6 +266,11 @@ type CreateOrUpdateEnvironmentJSONBody struct {
 	Name *string `json:"name,omitempty"`
 }
 
+// PromoteEnvironmentCanaryJSONBody defines parameters for PromoteEnvironmentCanary.
+type PromoteEnvironmentCanaryJSONBody struct {
+	Weight *int `json:"weight,omitempty"`
+}
+
 // CreateEnvironmentComposeFileJSONBody defines parameters for CreateEnvironmentComposeFile.
 type CreateEnvironmentComposeFileJSONBody struct {
 	// ComposeNoramlized Docker compose configuration as JSON object
@@ -128,18 +283,57 @@ type CreateEnvironmentComposeFileJSONBody struct {
 	Version string `json:"version"`
 }
 
+// ListEnvironmentDeploymentsParams defines parameters for ListEnvironmentDeployments.
+type ListEnvironmentDeploymentsParams struct {
+	// Limit Maximum number of deployments to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// SyncEnvironmentDomainsJSONBody defines parameters for SyncEnvironmentDomains.
+type SyncEnvironmentDomainsJSONBody struct {
+	// Domains Hostnames
+	Domains []string `json:"domains"`
+}
+
+// SetEnvironmentSecretJSONBody defines parameters for SetEnvironmentSecret.
+type SetEnvironmentSecretJSONBody struct {
+	// Ciphertext Base64 encoded AES-GCM ciphertext of the value
+	Ciphertext string `json:"ciphertext"`
+
+	// EncryptedKey Base64 encoded AES key, encrypted with the public key
+	EncryptedKey string `json:"encryptedKey"`
+
+	// KeyId ID of the public key used for encryption
+	KeyId string `json:"keyId"`
+
+	// Nonce Base64 encoded AES-GCM nonce
+	Nonce string `json:"nonce"`
+}
+
 // LintComposeFileObjectJSONRequestBody defines body for LintComposeFileObject for application/json ContentType.
 type LintComposeFileObjectJSONRequestBody LintComposeFileObjectJSONBody
 
+// DeployEnvironmentComposeFileJSONRequestBody defines body for DeployEnvironmentComposeFile for application/json ContentType.
+type DeployEnvironmentComposeFileJSONRequestBody = DeployRequest
+
 // CreateOrUpdateAppJSONRequestBody defines body for CreateOrUpdateApp for application/json ContentType.
 type CreateOrUpdateAppJSONRequestBody CreateOrUpdateAppJSONBody
 
 // CreateOrUpdateEnvironmentJSONRequestBody defines body for CreateOrUpdateEnvironment for application/json ContentType.
 type CreateOrUpdateEnvironmentJSONRequestBody CreateOrUpdateEnvironmentJSONBody
 
+// PromoteEnvironmentCanaryJSONRequestBody defines body for PromoteEnvironmentCanary for application/json ContentType.
+type PromoteEnvironmentCanaryJSONRequestBody PromoteEnvironmentCanaryJSONBody
+
 // CreateEnvironmentComposeFileJSONRequestBody defines body for CreateEnvironmentComposeFile for application/json ContentType.
 type CreateEnvironmentComposeFileJSONRequestBody CreateEnvironmentComposeFileJSONBody
 
+// SyncEnvironmentDomainsJSONRequestBody defines body for SyncEnvironmentDomains for application/json ContentType.
+type SyncEnvironmentDomainsJSONRequestBody SyncEnvironmentDomainsJSONBody
+
+// SetEnvironmentSecretJSONRequestBody defines body for SetEnvironmentSecret for application/json ContentType.
+type SetEnvironmentSecretJSONRequestBody SetEnvironmentSecretJSONBody
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -221,11 +415,22 @@ type ClientInterface interface {
 	// GetDeployment request
 	GetDeployment(ctx context.Context, deploymentId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CancelDeployment request
+	CancelDeployment(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDeploymentHealth request
 	GetDeploymentHealth(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeployEnvironmentComposeFile request
-	DeployEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetEnvironmentComposeFile request
+	GetEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeployEnvironmentComposeFileWithBody request with any body
+	DeployEnvironmentComposeFileWithBody(ctx context.Context, composeFileId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeployEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, body DeployEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationPlan request
+	GetOrganizationPlan(ctx context.Context, orgSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetProject request
 	GetProject(ctx context.Context, orgSlug string, projectSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -240,11 +445,56 @@ type ClientInterface interface {
 
 	CreateOrUpdateEnvironment(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetEnvironmentCanary request
+	GetEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AbortEnvironmentCanary request
+	AbortEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PromoteEnvironmentCanaryWithBody request with any body
+	PromoteEnvironmentCanaryWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PromoteEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body PromoteEnvironmentCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateEnvironmentComposeFileWithBody request with any body
 	CreateEnvironmentComposeFileWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateEnvironmentComposeFile(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetDeployedEnvironmentComposeFile request
+	GetDeployedEnvironmentComposeFile(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEnvironmentDeployments request
+	ListEnvironmentDeployments(ctx context.Context, orgSlug string, projectSlug string, envSlug string, params *ListEnvironmentDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEnvironmentDomains request
+	ListEnvironmentDomains(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncEnvironmentDomainsWithBody request with any body
+	SyncEnvironmentDomainsWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SyncEnvironmentDomains(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body SyncEnvironmentDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyEnvironmentDomain request
+	VerifyEnvironmentDomain(ctx context.Context, orgSlug string, projectSlug string, envSlug string, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListEnvironmentSecrets request
+	ListEnvironmentSecrets(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnvironmentSecretsPublicKey request
+	GetEnvironmentSecretsPublicKey(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteEnvironmentSecret request
+	DeleteEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEnvironmentSecret request
+	GetEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetEnvironmentSecretWithBody request with any body
+	SetEnvironmentSecretWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, body SetEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetApiV1Whoami request
 	GetApiV1Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
@@ -285,6 +535,18 @@ func (c *Client) GetDeployment(ctx context.Context, deploymentId string, reqEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) CancelDeployment(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelDeploymentRequest(c.Server, deploymentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetDeploymentHealth(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetDeploymentHealthRequest(c.Server, deploymentId)
 	if err != nil {
@@ -297,8 +559,44 @@ func (c *Client) GetDeploymentHealth(ctx context.Context, deploymentId openapi_t
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeployEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeployEnvironmentComposeFileRequest(c.Server, composeFileId)
+func (c *Client) GetEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentComposeFileRequest(c.Server, composeFileId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeployEnvironmentComposeFileWithBody(ctx context.Context, composeFileId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployEnvironmentComposeFileRequestWithBody(c.Server, composeFileId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeployEnvironmentComposeFile(ctx context.Context, composeFileId openapi_types.UUID, body DeployEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeployEnvironmentComposeFileRequest(c.Server, composeFileId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganizationPlan(ctx context.Context, orgSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationPlanRequest(c.Server, orgSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -369,8 +667,8 @@ func (c *Client) CreateOrUpdateEnvironment(ctx context.Context, orgSlug string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnvironmentComposeFileWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvironmentComposeFileRequestWithBody(c.Server, orgSlug, projectSlug, envSlug, contentType, body)
+func (c *Client) GetEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentCanaryRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -381,8 +679,8 @@ func (c *Client) CreateEnvironmentComposeFileWithBody(ctx context.Context, orgSl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateEnvironmentComposeFile(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateEnvironmentComposeFileRequest(c.Server, orgSlug, projectSlug, envSlug, body)
+func (c *Client) AbortEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAbortEnvironmentCanaryRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -393,8 +691,8 @@ func (c *Client) CreateEnvironmentComposeFile(ctx context.Context, orgSlug strin
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetApiV1Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetApiV1WhoamiRequest(c.Server)
+func (c *Client) PromoteEnvironmentCanaryWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteEnvironmentCanaryRequestWithBody(c.Server, orgSlug, projectSlug, envSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -405,172 +703,219 @@ func (c *Client) GetApiV1Whoami(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
-// NewLintComposeFileObjectRequest calls the generic LintComposeFileObject builder with application/json body
-func NewLintComposeFileObjectRequest(server string, body LintComposeFileObjectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PromoteEnvironmentCanary(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body PromoteEnvironmentCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPromoteEnvironmentCanaryRequest(c.Server, orgSlug, projectSlug, envSlug, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLintComposeFileObjectRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLintComposeFileObjectRequestWithBody generates requests for LintComposeFileObject with any type of body
-func NewLintComposeFileObjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateEnvironmentComposeFileWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentComposeFileRequestWithBody(c.Server, orgSlug, projectSlug, envSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/compose-files/lint")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateEnvironmentComposeFile(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateEnvironmentComposeFileRequest(c.Server, orgSlug, projectSlug, envSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDeploymentRequest generates requests for GetDeployment
-func NewGetDeploymentRequest(server string, deploymentId string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
+func (c *Client) GetDeployedEnvironmentComposeFile(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDeployedEnvironmentComposeFileRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListEnvironmentDeployments(ctx context.Context, orgSlug string, projectSlug string, envSlug string, params *ListEnvironmentDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEnvironmentDeploymentsRequest(c.Server, orgSlug, projectSlug, envSlug, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/deployment/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListEnvironmentDomains(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEnvironmentDomainsRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetDeploymentHealthRequest generates requests for GetDeploymentHealth
-func NewGetDeploymentHealthRequest(server string, deploymentId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
+func (c *Client) SyncEnvironmentDomainsWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncEnvironmentDomainsRequestWithBody(c.Server, orgSlug, projectSlug, envSlug, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SyncEnvironmentDomains(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body SyncEnvironmentDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncEnvironmentDomainsRequest(c.Server, orgSlug, projectSlug, envSlug, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/deployment/%s/health", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) VerifyEnvironmentDomain(ctx context.Context, orgSlug string, projectSlug string, envSlug string, hostname string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyEnvironmentDomainRequest(c.Server, orgSlug, projectSlug, envSlug, hostname)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeployEnvironmentComposeFileRequest generates requests for DeployEnvironmentComposeFile
-func NewDeployEnvironmentComposeFileRequest(server string, composeFileId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "composeFileId", runtime.ParamLocationPath, composeFileId)
+func (c *Client) ListEnvironmentSecrets(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEnvironmentSecretsRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetEnvironmentSecretsPublicKey(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentSecretsPublicKeyRequest(c.Server, orgSlug, projectSlug, envSlug)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/environment-compose-files/%s/deploy", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteEnvironmentSecretRequest(c.Server, orgSlug, projectSlug, envSlug, secretName)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) GetEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEnvironmentSecretRequest(c.Server, orgSlug, projectSlug, envSlug, secretName)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetProjectRequest generates requests for GetProject
-func NewGetProjectRequest(server string, orgSlug string, projectSlug string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+func (c *Client) SetEnvironmentSecretWithBody(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEnvironmentSecretRequestWithBody(c.Server, orgSlug, projectSlug, envSlug, secretName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+func (c *Client) SetEnvironmentSecret(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, body SetEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetEnvironmentSecretRequest(c.Server, orgSlug, projectSlug, envSlug, secretName, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) GetApiV1Whoami(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetApiV1WhoamiRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+// NewLintComposeFileObjectRequest calls the generic LintComposeFileObject builder with application/json body
+func NewLintComposeFileObjectRequest(server string, body LintComposeFileObjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLintComposeFileObjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewLintComposeFileObjectRequestWithBody generates requests for LintComposeFileObject with any type of body
+func NewLintComposeFileObjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/compose-files/lint")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -580,39 +925,57 @@ func NewGetProjectRequest(server string, orgSlug string, projectSlug string) (*h
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCreateOrUpdateAppRequest calls the generic CreateOrUpdateApp builder with application/json body
-func NewCreateOrUpdateAppRequest(server string, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetDeploymentRequest generates requests for GetDeployment
+func NewGetDeploymentRequest(server string, deploymentId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrUpdateAppRequestWithBody(server, orgSlug, projectSlug, "application/json", bodyReader)
-}
 
-// NewCreateOrUpdateAppRequestWithBody generates requests for CreateOrUpdateApp with any type of body
-func NewCreateOrUpdateAppRequestWithBody(server string, orgSlug string, projectSlug string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	var pathParam0 string
+	operationPath := fmt.Sprintf("/api/v1/deployment/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	return req, nil
+}
+
+// NewCancelDeploymentRequest generates requests for CancelDeployment
+func NewCancelDeploymentRequest(server string, deploymentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
 	if err != nil {
 		return nil, err
 	}
@@ -622,7 +985,7 @@ func NewCreateOrUpdateAppRequestWithBody(server string, orgSlug string, projectS
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/api/v1/deployment/%s/cancel", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -632,48 +995,55 @@ func NewCreateOrUpdateAppRequestWithBody(server string, orgSlug string, projectS
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateOrUpdateEnvironmentRequest calls the generic CreateOrUpdateEnvironment builder with application/json body
-func NewCreateOrUpdateEnvironmentRequest(server string, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetDeploymentHealthRequest generates requests for GetDeploymentHealth
+func NewGetDeploymentHealthRequest(server string, deploymentId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deploymentId", runtime.ParamLocationPath, deploymentId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateOrUpdateEnvironmentRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
-}
 
-// NewCreateOrUpdateEnvironmentRequestWithBody generates requests for CreateOrUpdateEnvironment with any type of body
-func NewCreateOrUpdateEnvironmentRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	var pathParam0 string
+	operationPath := fmt.Sprintf("/api/v1/deployment/%s/health", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	return req, nil
+}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+// NewGetEnvironmentComposeFileRequest generates requests for GetEnvironmentComposeFile
+func NewGetEnvironmentComposeFileRequest(server string, composeFileId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "composeFileId", runtime.ParamLocationPath, composeFileId)
 	if err != nil {
 		return nil, err
 	}
@@ -683,7 +1053,7 @@ func NewCreateOrUpdateEnvironmentRequestWithBody(server string, orgSlug string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/environment-compose-files/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -693,48 +1063,68 @@ func NewCreateOrUpdateEnvironmentRequestWithBody(server string, orgSlug string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewCreateEnvironmentComposeFileRequest calls the generic CreateEnvironmentComposeFile builder with application/json body
-func NewCreateEnvironmentComposeFileRequest(server string, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody) (*http.Request, error) {
+// NewDeployEnvironmentComposeFileRequest calls the generic DeployEnvironmentComposeFile builder with application/json body
+func NewDeployEnvironmentComposeFileRequest(server string, composeFileId openapi_types.UUID, body DeployEnvironmentComposeFileJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateEnvironmentComposeFileRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
+	return NewDeployEnvironmentComposeFileRequestWithBody(server, composeFileId, "application/json", bodyReader)
 }
 
-// NewCreateEnvironmentComposeFileRequestWithBody generates requests for CreateEnvironmentComposeFile with any type of body
-func NewCreateEnvironmentComposeFileRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeployEnvironmentComposeFileRequestWithBody generates requests for DeployEnvironmentComposeFile with any type of body
+func NewDeployEnvironmentComposeFileRequestWithBody(server string, composeFileId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "composeFileId", runtime.ParamLocationPath, composeFileId)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	operationPath := fmt.Sprintf("/api/v1/environment-compose-files/%s/deploy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam2 string
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetOrganizationPlanRequest generates requests for GetOrganizationPlan
+func NewGetOrganizationPlanRequest(server string, orgSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
 	if err != nil {
 		return nil, err
 	}
@@ -744,7 +1134,7 @@ func NewCreateEnvironmentComposeFileRequestWithBody(server string, orgSlug strin
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/compose-file", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/plan", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -754,26 +1144,38 @@ func NewCreateEnvironmentComposeFileRequestWithBody(server string, orgSlug strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetApiV1WhoamiRequest generates requests for GetApiV1Whoami
-func NewGetApiV1WhoamiRequest(server string) (*http.Request, error) {
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, orgSlug string, projectSlug string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/whoami")
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -791,626 +1193,3117 @@ func NewGetApiV1WhoamiRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewCreateOrUpdateAppRequest calls the generic CreateOrUpdateApp builder with application/json body
+func NewCreateOrUpdateAppRequest(server string, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrUpdateAppRequestWithBody(server, orgSlug, projectSlug, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewCreateOrUpdateAppRequestWithBody generates requests for CreateOrUpdateApp with any type of body
+func NewCreateOrUpdateAppRequestWithBody(server string, orgSlug string, projectSlug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LintComposeFileObjectWithBodyWithResponse request with any body
-	LintComposeFileObjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error)
+// NewCreateOrUpdateEnvironmentRequest calls the generic CreateOrUpdateEnvironment builder with application/json body
+func NewCreateOrUpdateEnvironmentRequest(server string, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateOrUpdateEnvironmentRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
+}
 
-	LintComposeFileObjectWithResponse(ctx context.Context, body LintComposeFileObjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error)
+// NewCreateOrUpdateEnvironmentRequestWithBody generates requests for CreateOrUpdateEnvironment with any type of body
+func NewCreateOrUpdateEnvironmentRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetDeploymentWithResponse request
-	GetDeploymentWithResponse(ctx context.Context, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentResponse, error)
+	var pathParam0 string
 
-	// GetDeploymentHealthWithResponse request
-	GetDeploymentHealthWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDeploymentHealthResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeployEnvironmentComposeFileWithResponse request
-	DeployEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error)
+	var pathParam1 string
 
-	// GetProjectWithResponse request
-	GetProjectWithResponse(ctx context.Context, orgSlug string, projectSlug string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrUpdateAppWithBodyWithResponse request with any body
-	CreateOrUpdateAppWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error)
+	var pathParam2 string
 
-	CreateOrUpdateAppWithResponse(ctx context.Context, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateOrUpdateEnvironmentWithBodyWithResponse request with any body
-	CreateOrUpdateEnvironmentWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateOrUpdateEnvironmentWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error)
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CreateEnvironmentComposeFileWithBodyWithResponse request with any body
-	CreateEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetApiV1WhoamiWithResponse request
-	GetApiV1WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1WhoamiResponse, error)
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type LintComposeFileObjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Results []LintingIssue `json:"results"`
+// NewGetEnvironmentCanaryRequest generates requests for GetEnvironmentCanary
+func NewGetEnvironmentCanaryRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *struct {
-		Error string `json:"error"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r LintComposeFileObjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LintComposeFileObjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/canary", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-type GetDeploymentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		CreatedAt     time.Time          `json:"createdAt"`
-		EnvironmentId openapi_types.UUID `json:"environmentId"`
-		Id            openapi_types.UUID `json:"id"`
-		Logs          *[]struct {
-			Log       string    `json:"log"`
-			Stream    string    `json:"stream"`
-			Timestamp time.Time `json:"timestamp"`
-		} `json:"logs,omitempty"`
-		Status    string             `json:"status"`
-		UpdatedAt time.Time          `json:"updatedAt"`
-		VersionId openapi_types.UUID `json:"versionId"`
+// NewAbortEnvironmentCanaryRequest generates requests for AbortEnvironmentCanary
+func NewAbortEnvironmentCanaryRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ZodError
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetDeploymentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/canary/abort", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDeploymentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPromoteEnvironmentCanaryRequest calls the generic PromoteEnvironmentCanary builder with application/json body
+func NewPromoteEnvironmentCanaryRequest(server string, orgSlug string, projectSlug string, envSlug string, body PromoteEnvironmentCanaryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPromoteEnvironmentCanaryRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
 }
 
-type GetDeploymentHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		DeploymentId openapi_types.UUID `json:"deploymentId"`
+// NewPromoteEnvironmentCanaryRequestWithBody generates requests for PromoteEnvironmentCanary with any type of body
+func NewPromoteEnvironmentCanaryRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-		// Error Error message if health check failed
-		Error  *string `json:"error,omitempty"`
-		Health *struct {
-			Events *[]struct {
-				Count          *float32 `json:"count,omitempty"`
-				FirstTimestamp *string  `json:"firstTimestamp,omitempty"`
-				InvolvedObject *struct {
-					Kind *string `json:"kind,omitempty"`
-					Name *string `json:"name,omitempty"`
-				} `json:"involvedObject,omitempty"`
-				LastTimestamp *string `json:"lastTimestamp,omitempty"`
-				Message       *string `json:"message,omitempty"`
-				Reason        *string `json:"reason,omitempty"`
-				Source        *string `json:"source,omitempty"`
-				Type          *string `json:"type,omitempty"`
-			} `json:"events,omitempty"`
-			Pods *[]struct {
-				Age        *string `json:"age,omitempty"`
-				Conditions *[]struct {
-					LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
-					Message            *string `json:"message,omitempty"`
-					Reason             *string `json:"reason,omitempty"`
-					Status             *string `json:"status,omitempty"`
-					Type               *string `json:"type,omitempty"`
-				} `json:"conditions,omitempty"`
-				ContainerStatuses *[]struct {
-					LastState    *map[string]interface{} `json:"lastState,omitempty"`
-					Name         *string                 `json:"name,omitempty"`
-					Ready        *bool                   `json:"ready,omitempty"`
-					RestartCount *float32                `json:"restartCount,omitempty"`
-					State        *map[string]interface{} `json:"state,omitempty"`
-				} `json:"containerStatuses,omitempty"`
-				Ip       *string  `json:"ip,omitempty"`
-				Name     *string  `json:"name,omitempty"`
-				Node     *string  `json:"node,omitempty"`
-				Phase    *string  `json:"phase,omitempty"`
-				Ready    *bool    `json:"ready,omitempty"`
-				Restarts *float32 `json:"restarts,omitempty"`
-			} `json:"pods,omitempty"`
-			Resources *struct {
-				Deployments *[]struct {
-					Available  *float32 `json:"available,omitempty"`
-					Conditions *[]struct {
-						Message *string `json:"message,omitempty"`
-						Reason  *string `json:"reason,omitempty"`
-						Status  *string `json:"status,omitempty"`
-						Type    *string `json:"type,omitempty"`
-					} `json:"conditions,omitempty"`
-					Desired *float32 `json:"desired,omitempty"`
-					Name    *string  `json:"name,omitempty"`
-					Ready   *float32 `json:"ready,omitempty"`
-					Updated *float32 `json:"updated,omitempty"`
-				} `json:"deployments,omitempty"`
-				Ingresses *[]struct {
-					Hosts *[]string `json:"hosts,omitempty"`
-					Name  *string   `json:"name,omitempty"`
-					Ready *bool     `json:"ready,omitempty"`
-				} `json:"ingresses,omitempty"`
-				Services *[]struct {
-					ClusterIP *string `json:"clusterIP,omitempty"`
-					Name      *string `json:"name,omitempty"`
-					Ports     *[]struct {
-						Port     *float32 `json:"port,omitempty"`
-						Protocol *string  `json:"protocol,omitempty"`
+	var pathParam0 string
 
-						// TargetPort Port number or name that containers expose (can be numeric string like '8080' or named port like 'http')
-						TargetPort *string `json:"targetPort,omitempty"`
-					} `json:"ports,omitempty"`
-					Type *string `json:"type,omitempty"`
-				} `json:"services,omitempty"`
-			} `json:"resources,omitempty"`
-		} `json:"health,omitempty"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
 
-		// HealthScore Health score from 0-100
-		HealthScore     float32                      `json:"healthScore"`
-		LastHealthCheck *time.Time                   `json:"lastHealthCheck,omitempty"`
-		Status          GetDeploymentHealth200Status `json:"status"`
+	var pathParam1 string
 
-		// Summary Human-readable health summary
-		Summary         string `json:"summary"`
-		Troubleshooting *struct {
-			HealthScore   *float32  `json:"healthScore,omitempty"`
-			QuickCommands *[]string `json:"quickCommands,omitempty"`
-			Suggestions   *[]string `json:"suggestions,omitempty"`
-			Summary       *string   `json:"summary,omitempty"`
-		} `json:"troubleshooting,omitempty"`
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-}
-type GetDeploymentHealth200Status string
 
-// Status returns HTTPResponse.Status
-func (r GetDeploymentHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/canary/promote", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDeploymentHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateEnvironmentComposeFileRequest calls the generic CreateEnvironmentComposeFile builder with application/json body
+func NewCreateEnvironmentComposeFileRequest(server string, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateEnvironmentComposeFileRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
 }
 
-type DeployEnvironmentComposeFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Deployments []struct {
-			// CreatedAt Creation timestamp
-			CreatedAt *time.Time `json:"createdAt,omitempty"`
+// NewCreateEnvironmentComposeFileRequestWithBody generates requests for CreateEnvironmentComposeFile with any type of body
+func NewCreateEnvironmentComposeFileRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			// EnvironmentComposeFileId The compose file ID
-			EnvironmentComposeFileId *openapi_types.UUID `json:"environmentComposeFileId,omitempty"`
+	var pathParam0 string
 
-			// EnvironmentId The environment ID
-			EnvironmentId *openapi_types.UUID `json:"environmentId,omitempty"`
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
 
-			// Id The deployment ID
-			Id *openapi_types.UUID `json:"id,omitempty"`
+	var pathParam1 string
 
-			// Status The deployment status
-			Status *string `json:"status,omitempty"`
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
 
-			// UpdatedAt Last update timestamp
-			UpdatedAt *time.Time `json:"updatedAt,omitempty"`
-		} `json:"deployments"`
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ZodError
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/compose-file", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r DeployEnvironmentComposeFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeployEnvironmentComposeFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-type GetProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		CreatedAt    time.Time `json:"createdAt"`
-		Description  *string   `json:"description,omitempty"`
-		Environments *[]struct {
-			ComposeFiles *[]EnvironmentComposeFile `json:"composeFiles,omitempty"`
-			CreatedAt    time.Time                 `json:"createdAt"`
-			Id           openapi_types.UUID        `json:"id"`
-			Name         string                    `json:"name"`
-			Slug         string                    `json:"slug"`
-			UpdatedAt    time.Time                 `json:"updatedAt"`
-		} `json:"environments,omitempty"`
-		Id        openapi_types.UUID `json:"id"`
-		Name      string             `json:"name"`
-		Slug      string             `json:"slug"`
-		UpdatedAt time.Time          `json:"updatedAt"`
+// NewGetDeployedEnvironmentComposeFileRequest generates requests for GetDeployedEnvironmentComposeFile
+func NewGetDeployedEnvironmentComposeFileRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r GetProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/compose-file/deployed", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type CreateOrUpdateAppResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		CreatedAt    time.Time          `json:"createdAt"`
-		Description  *string            `json:"description,omitempty"`
-		Environments *[]Environment     `json:"environments,omitempty"`
-		Id           openapi_types.UUID `json:"id"`
-		Name         string             `json:"name"`
-		Organization *Organization      `json:"organization,omitempty"`
-		Slug         string             `json:"slug"`
-		UpdatedAt    time.Time          `json:"updatedAt"`
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ZodError
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateOrUpdateAppResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewListEnvironmentDeploymentsRequest generates requests for ListEnvironmentDeployments
+func NewListEnvironmentDeploymentsRequest(server string, orgSlug string, projectSlug string, envSlug string, params *ListEnvironmentDeploymentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrUpdateAppResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateOrUpdateEnvironmentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Environment
-	JSON400      *ZodError
-	JSON401      *struct {
-		Error string `json:"error"`
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateOrUpdateEnvironmentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/deployments", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateOrUpdateEnvironmentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-type CreateEnvironmentComposeFileResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Compose       map[string]interface{} `json:"compose"`
-		CreatedAt     time.Time              `json:"createdAt"`
-		Environment   *Environment           `json:"environment,omitempty"`
-		EnvironmentId openapi_types.UUID     `json:"environmentId"`
-		Id            openapi_types.UUID     `json:"id"`
-		RawCompose    string                 `json:"rawCompose"`
-		UpdatedAt     time.Time              `json:"updatedAt"`
-		Version       string                 `json:"version"`
+// NewListEnvironmentDomainsRequest generates requests for ListEnvironmentDomains
+func NewListEnvironmentDomainsRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON400 *ZodError
-	JSON401 *struct {
-		Error string `json:"error"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON404 *struct {
-		Error string `json:"error"`
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r CreateEnvironmentComposeFileResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/domains", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateEnvironmentComposeFileResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewSyncEnvironmentDomainsRequest calls the generic SyncEnvironmentDomains builder with application/json body
+func NewSyncEnvironmentDomainsRequest(server string, orgSlug string, projectSlug string, envSlug string, body SyncEnvironmentDomainsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewSyncEnvironmentDomainsRequestWithBody(server, orgSlug, projectSlug, envSlug, "application/json", bodyReader)
 }
 
-type GetApiV1WhoamiResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Key *struct {
-			CreatedAt   *string              `json:"createdAt,omitempty"`
-			Id          *openapi_types.UUID  `json:"id,omitempty"`
-			Metadata    *map[string]string   `json:"metadata,omitempty"`
-			Name        *string              `json:"name,omitempty"`
-			Permissions *map[string][]string `json:"permissions,omitempty"`
-			UpdatedAt   *string              `json:"updatedAt,omitempty"`
-			UserId      *openapi_types.UUID  `json:"userId,omitempty"`
-		} `json:"key,omitempty"`
-		Organization *Organization `json:"organization,omitempty"`
-		Session      *struct {
-			CreatedAt *string             `json:"createdAt,omitempty"`
-			Id        *openapi_types.UUID `json:"id,omitempty"`
-			UpdatedAt *string             `json:"updatedAt,omitempty"`
-			UserId    *openapi_types.UUID `json:"userId,omitempty"`
-		} `json:"session,omitempty"`
+// NewSyncEnvironmentDomainsRequestWithBody generates requests for SyncEnvironmentDomains with any type of body
+func NewSyncEnvironmentDomainsRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
 	}
-	JSON401 *struct {
-		Error *string `json:"error,omitempty"`
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/domains", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetApiV1WhoamiResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// NewVerifyEnvironmentDomainRequest generates requests for VerifyEnvironmentDomain
+func NewVerifyEnvironmentDomainRequest(server string, orgSlug string, projectSlug string, envSlug string, hostname string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "hostname", runtime.ParamLocationPath, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/domains/%s/verify", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEnvironmentSecretsRequest generates requests for ListEnvironmentSecrets
+func NewListEnvironmentSecretsRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/secrets", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEnvironmentSecretsPublicKeyRequest generates requests for GetEnvironmentSecretsPublicKey
+func NewGetEnvironmentSecretsPublicKeyRequest(server string, orgSlug string, projectSlug string, envSlug string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/secrets/public-key", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteEnvironmentSecretRequest generates requests for DeleteEnvironmentSecret
+func NewDeleteEnvironmentSecretRequest(server string, orgSlug string, projectSlug string, envSlug string, secretName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "secretName", runtime.ParamLocationPath, secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/secrets/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEnvironmentSecretRequest generates requests for GetEnvironmentSecret
+func NewGetEnvironmentSecretRequest(server string, orgSlug string, projectSlug string, envSlug string, secretName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "secretName", runtime.ParamLocationPath, secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/secrets/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetEnvironmentSecretRequest calls the generic SetEnvironmentSecret builder with application/json body
+func NewSetEnvironmentSecretRequest(server string, orgSlug string, projectSlug string, envSlug string, secretName string, body SetEnvironmentSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetEnvironmentSecretRequestWithBody(server, orgSlug, projectSlug, envSlug, secretName, "application/json", bodyReader)
+}
+
+// NewSetEnvironmentSecretRequestWithBody generates requests for SetEnvironmentSecret with any type of body
+func NewSetEnvironmentSecretRequestWithBody(server string, orgSlug string, projectSlug string, envSlug string, secretName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orgSlug", runtime.ParamLocationPath, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "projectSlug", runtime.ParamLocationPath, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "envSlug", runtime.ParamLocationPath, envSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "secretName", runtime.ParamLocationPath, secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/organizations/%s/projects/%s/environments/%s/secrets/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetApiV1WhoamiRequest generates requests for GetApiV1Whoami
+func NewGetApiV1WhoamiRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/whoami")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// LintComposeFileObjectWithBodyWithResponse request with any body
+	LintComposeFileObjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error)
+
+	LintComposeFileObjectWithResponse(ctx context.Context, body LintComposeFileObjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error)
+
+	// GetDeploymentWithResponse request
+	GetDeploymentWithResponse(ctx context.Context, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentResponse, error)
+
+	// CancelDeploymentWithResponse request
+	CancelDeploymentWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error)
+
+	// GetDeploymentHealthWithResponse request
+	GetDeploymentHealthWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDeploymentHealthResponse, error)
+
+	// GetEnvironmentComposeFileWithResponse request
+	GetEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEnvironmentComposeFileResponse, error)
+
+	// DeployEnvironmentComposeFileWithBodyWithResponse request with any body
+	DeployEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, composeFileId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error)
+
+	DeployEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, body DeployEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error)
+
+	// GetOrganizationPlanWithResponse request
+	GetOrganizationPlanWithResponse(ctx context.Context, orgSlug string, reqEditors ...RequestEditorFn) (*GetOrganizationPlanResponse, error)
+
+	// GetProjectWithResponse request
+	GetProjectWithResponse(ctx context.Context, orgSlug string, projectSlug string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// CreateOrUpdateAppWithBodyWithResponse request with any body
+	CreateOrUpdateAppWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error)
+
+	CreateOrUpdateAppWithResponse(ctx context.Context, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error)
+
+	// CreateOrUpdateEnvironmentWithBodyWithResponse request with any body
+	CreateOrUpdateEnvironmentWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error)
+
+	CreateOrUpdateEnvironmentWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error)
+
+	// GetEnvironmentCanaryWithResponse request
+	GetEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetEnvironmentCanaryResponse, error)
+
+	// AbortEnvironmentCanaryWithResponse request
+	AbortEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*AbortEnvironmentCanaryResponse, error)
+
+	// PromoteEnvironmentCanaryWithBodyWithResponse request with any body
+	PromoteEnvironmentCanaryWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteEnvironmentCanaryResponse, error)
+
+	PromoteEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body PromoteEnvironmentCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteEnvironmentCanaryResponse, error)
+
+	// CreateEnvironmentComposeFileWithBodyWithResponse request with any body
+	CreateEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error)
+
+	CreateEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error)
+
+	// GetDeployedEnvironmentComposeFileWithResponse request
+	GetDeployedEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetDeployedEnvironmentComposeFileResponse, error)
+
+	// ListEnvironmentDeploymentsWithResponse request
+	ListEnvironmentDeploymentsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, params *ListEnvironmentDeploymentsParams, reqEditors ...RequestEditorFn) (*ListEnvironmentDeploymentsResponse, error)
+
+	// ListEnvironmentDomainsWithResponse request
+	ListEnvironmentDomainsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*ListEnvironmentDomainsResponse, error)
+
+	// SyncEnvironmentDomainsWithBodyWithResponse request with any body
+	SyncEnvironmentDomainsWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncEnvironmentDomainsResponse, error)
+
+	SyncEnvironmentDomainsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body SyncEnvironmentDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncEnvironmentDomainsResponse, error)
+
+	// VerifyEnvironmentDomainWithResponse request
+	VerifyEnvironmentDomainWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, hostname string, reqEditors ...RequestEditorFn) (*VerifyEnvironmentDomainResponse, error)
+
+	// ListEnvironmentSecretsWithResponse request
+	ListEnvironmentSecretsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*ListEnvironmentSecretsResponse, error)
+
+	// GetEnvironmentSecretsPublicKeyWithResponse request
+	GetEnvironmentSecretsPublicKeyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsPublicKeyResponse, error)
+
+	// DeleteEnvironmentSecretWithResponse request
+	DeleteEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*DeleteEnvironmentSecretResponse, error)
+
+	// GetEnvironmentSecretWithResponse request
+	GetEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretResponse, error)
+
+	// SetEnvironmentSecretWithBodyWithResponse request with any body
+	SetEnvironmentSecretWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretResponse, error)
+
+	SetEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, body SetEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretResponse, error)
+
+	// GetApiV1WhoamiWithResponse request
+	GetApiV1WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1WhoamiResponse, error)
+}
+
+type LintComposeFileObjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Results []LintingIssue `json:"results"`
+	}
+	JSON400 *struct {
+		Error string `json:"error"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r LintComposeFileObjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LintComposeFileObjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CreatedAt     time.Time          `json:"createdAt"`
+		EnvironmentId openapi_types.UUID `json:"environmentId"`
+		Id            openapi_types.UUID `json:"id"`
+		Logs          *[]struct {
+			Log       string    `json:"log"`
+			Stream    string    `json:"stream"`
+			Timestamp time.Time `json:"timestamp"`
+		} `json:"logs,omitempty"`
+		Status    string             `json:"status"`
+		UpdatedAt time.Time          `json:"updatedAt"`
+		VersionId openapi_types.UUID `json:"versionId"`
+	}
+	JSON400 *ZodError
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Deployment
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+	JSON409 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeploymentHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		DeploymentId openapi_types.UUID `json:"deploymentId"`
+
+		// Error Error message if health check failed
+		Error  *string `json:"error,omitempty"`
+		Health *struct {
+			Events *[]struct {
+				Count          *float32 `json:"count,omitempty"`
+				FirstTimestamp *string  `json:"firstTimestamp,omitempty"`
+				InvolvedObject *struct {
+					Kind *string `json:"kind,omitempty"`
+					Name *string `json:"name,omitempty"`
+				} `json:"involvedObject,omitempty"`
+				LastTimestamp *string `json:"lastTimestamp,omitempty"`
+				Message       *string `json:"message,omitempty"`
+				Reason        *string `json:"reason,omitempty"`
+				Source        *string `json:"source,omitempty"`
+				Type          *string `json:"type,omitempty"`
+			} `json:"events,omitempty"`
+			Pods *[]struct {
+				Age        *string `json:"age,omitempty"`
+				Conditions *[]struct {
+					LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+					Message            *string `json:"message,omitempty"`
+					Reason             *string `json:"reason,omitempty"`
+					Status             *string `json:"status,omitempty"`
+					Type               *string `json:"type,omitempty"`
+				} `json:"conditions,omitempty"`
+				ContainerStatuses *[]struct {
+					LastState    *map[string]interface{} `json:"lastState,omitempty"`
+					Name         *string                 `json:"name,omitempty"`
+					Ready        *bool                   `json:"ready,omitempty"`
+					RestartCount *float32                `json:"restartCount,omitempty"`
+					State        *map[string]interface{} `json:"state,omitempty"`
+				} `json:"containerStatuses,omitempty"`
+				Ip       *string  `json:"ip,omitempty"`
+				Name     *string  `json:"name,omitempty"`
+				Node     *string  `json:"node,omitempty"`
+				Phase    *string  `json:"phase,omitempty"`
+				Ready    *bool    `json:"ready,omitempty"`
+				Restarts *float32 `json:"restarts,omitempty"`
+			} `json:"pods,omitempty"`
+			Resources *struct {
+				Deployments *[]struct {
+					Available  *float32 `json:"available,omitempty"`
+					Conditions *[]struct {
+						Message *string `json:"message,omitempty"`
+						Reason  *string `json:"reason,omitempty"`
+						Status  *string `json:"status,omitempty"`
+						Type    *string `json:"type,omitempty"`
+					} `json:"conditions,omitempty"`
+					Desired *float32 `json:"desired,omitempty"`
+					Name    *string  `json:"name,omitempty"`
+					Ready   *float32 `json:"ready,omitempty"`
+					Updated *float32 `json:"updated,omitempty"`
+				} `json:"deployments,omitempty"`
+				Ingresses *[]struct {
+					Hosts *[]string `json:"hosts,omitempty"`
+					Name  *string   `json:"name,omitempty"`
+					Ready *bool     `json:"ready,omitempty"`
+				} `json:"ingresses,omitempty"`
+				Services *[]struct {
+					ClusterIP *string `json:"clusterIP,omitempty"`
+					Name      *string `json:"name,omitempty"`
+					Ports     *[]struct {
+						Port     *float32 `json:"port,omitempty"`
+						Protocol *string  `json:"protocol,omitempty"`
+
+						// TargetPort Port number or name that containers expose (can be numeric string like '8080' or named port like 'http')
+						TargetPort *string `json:"targetPort,omitempty"`
+					} `json:"ports,omitempty"`
+					Type *string `json:"type,omitempty"`
+				} `json:"services,omitempty"`
+			} `json:"resources,omitempty"`
+		} `json:"health,omitempty"`
+
+		// HealthScore Health score from 0-100
+		HealthScore     float32                      `json:"healthScore"`
+		LastHealthCheck *time.Time                   `json:"lastHealthCheck,omitempty"`
+		Status          GetDeploymentHealth200Status `json:"status"`
+
+		// Summary Human-readable health summary
+		Summary         string `json:"summary"`
+		Troubleshooting *struct {
+			HealthScore   *float32  `json:"healthScore,omitempty"`
+			QuickCommands *[]string `json:"quickCommands,omitempty"`
+			Suggestions   *[]string `json:"suggestions,omitempty"`
+			Summary       *string   `json:"summary,omitempty"`
+		} `json:"troubleshooting,omitempty"`
+	}
+}
+type GetDeploymentHealth200Status string
+
+// Status returns HTTPResponse.Status
+func (r GetDeploymentHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeploymentHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentComposeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentComposeFile
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentComposeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentComposeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeployEnvironmentComposeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Deployments []struct {
+			// CreatedAt Creation timestamp
+			CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+			// EnvironmentComposeFileId The compose file ID
+			EnvironmentComposeFileId *openapi_types.UUID `json:"environmentComposeFileId,omitempty"`
+
+			// EnvironmentId The environment ID
+			EnvironmentId *openapi_types.UUID `json:"environmentId,omitempty"`
+
+			// Id The deployment ID
+			Id *openapi_types.UUID `json:"id,omitempty"`
+
+			// Status The deployment status
+			Status *string `json:"status,omitempty"`
+
+			// UpdatedAt Last update timestamp
+			UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+		} `json:"deployments"`
+	}
+	JSON400 *ZodError
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeployEnvironmentComposeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeployEnvironmentComposeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationPlanResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PlanLimits
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationPlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationPlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CreatedAt    time.Time `json:"createdAt"`
+		Description  *string   `json:"description,omitempty"`
+		Environments *[]struct {
+			ComposeFiles *[]EnvironmentComposeFile `json:"composeFiles,omitempty"`
+			CreatedAt    time.Time                 `json:"createdAt"`
+			Id           openapi_types.UUID        `json:"id"`
+			Name         string                    `json:"name"`
+			Slug         string                    `json:"slug"`
+			UpdatedAt    time.Time                 `json:"updatedAt"`
+		} `json:"environments,omitempty"`
+		Id        openapi_types.UUID `json:"id"`
+		Name      string             `json:"name"`
+		Slug      string             `json:"slug"`
+		UpdatedAt time.Time          `json:"updatedAt"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrUpdateAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		CreatedAt    time.Time          `json:"createdAt"`
+		Description  *string            `json:"description,omitempty"`
+		Environments *[]Environment     `json:"environments,omitempty"`
+		Id           openapi_types.UUID `json:"id"`
+		Name         string             `json:"name"`
+		Organization *Organization      `json:"organization,omitempty"`
+		Slug         string             `json:"slug"`
+		UpdatedAt    time.Time          `json:"updatedAt"`
+	}
+	JSON400 *ZodError
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrUpdateAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrUpdateAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateOrUpdateEnvironmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Environment
+	JSON400      *ZodError
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateOrUpdateEnvironmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateOrUpdateEnvironmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentCanaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRollout
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentCanaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentCanaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AbortEnvironmentCanaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRollout
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r AbortEnvironmentCanaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AbortEnvironmentCanaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PromoteEnvironmentCanaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CanaryRollout
+	JSON400      *ZodError
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PromoteEnvironmentCanaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PromoteEnvironmentCanaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateEnvironmentComposeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Compose       map[string]interface{} `json:"compose"`
+		CreatedAt     time.Time              `json:"createdAt"`
+		Environment   *Environment           `json:"environment,omitempty"`
+		EnvironmentId openapi_types.UUID     `json:"environmentId"`
+		Id            openapi_types.UUID     `json:"id"`
+		RawCompose    string                 `json:"rawCompose"`
+		UpdatedAt     time.Time              `json:"updatedAt"`
+		Version       string                 `json:"version"`
+	}
+	JSON400 *ZodError
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateEnvironmentComposeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateEnvironmentComposeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDeployedEnvironmentComposeFileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EnvironmentComposeFile
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDeployedEnvironmentComposeFileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDeployedEnvironmentComposeFileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEnvironmentDeploymentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DeploymentSummary
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEnvironmentDeploymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEnvironmentDeploymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEnvironmentDomainsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Domains []Domain `json:"domains"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEnvironmentDomainsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEnvironmentDomainsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncEnvironmentDomainsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Domains []Domain `json:"domains"`
+	}
+	JSON400 *ZodError
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncEnvironmentDomainsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncEnvironmentDomainsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyEnvironmentDomainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Domain
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyEnvironmentDomainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyEnvironmentDomainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEnvironmentSecretsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Secrets []Secret `json:"secrets"`
+	}
+	JSON401 *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEnvironmentSecretsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEnvironmentSecretsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentSecretsPublicKeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SecretPublicKey
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentSecretsPublicKeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentSecretsPublicKeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteEnvironmentSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteEnvironmentSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteEnvironmentSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEnvironmentSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secret
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEnvironmentSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEnvironmentSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetEnvironmentSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Secret
+	JSON400      *ZodError
+	JSON401      *struct {
+		Error string `json:"error"`
+	}
+	JSON404 *struct {
+		Error string `json:"error"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r SetEnvironmentSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetEnvironmentSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetApiV1WhoamiResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Key *struct {
+			CreatedAt   *string              `json:"createdAt,omitempty"`
+			Id          *openapi_types.UUID  `json:"id,omitempty"`
+			Metadata    *map[string]string   `json:"metadata,omitempty"`
+			Name        *string              `json:"name,omitempty"`
+			Permissions *map[string][]string `json:"permissions,omitempty"`
+			UpdatedAt   *string              `json:"updatedAt,omitempty"`
+			UserId      *openapi_types.UUID  `json:"userId,omitempty"`
+		} `json:"key,omitempty"`
+		Organization *Organization `json:"organization,omitempty"`
+		Session      *struct {
+			CreatedAt *string             `json:"createdAt,omitempty"`
+			Id        *openapi_types.UUID `json:"id,omitempty"`
+			UpdatedAt *string             `json:"updatedAt,omitempty"`
+			UserId    *openapi_types.UUID `json:"userId,omitempty"`
+		} `json:"session,omitempty"`
+	}
+	JSON401 *struct {
+		Error *string `json:"error,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetApiV1WhoamiResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetApiV1WhoamiResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// LintComposeFileObjectWithBodyWithResponse request with arbitrary body returning *LintComposeFileObjectResponse
+func (c *ClientWithResponses) LintComposeFileObjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error) {
+	rsp, err := c.LintComposeFileObjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLintComposeFileObjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) LintComposeFileObjectWithResponse(ctx context.Context, body LintComposeFileObjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error) {
+	rsp, err := c.LintComposeFileObject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLintComposeFileObjectResponse(rsp)
+}
+
+// GetDeploymentWithResponse request returning *GetDeploymentResponse
+func (c *ClientWithResponses) GetDeploymentWithResponse(ctx context.Context, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentResponse, error) {
+	rsp, err := c.GetDeployment(ctx, deploymentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentResponse(rsp)
+}
+
+// CancelDeploymentWithResponse request returning *CancelDeploymentResponse
+func (c *ClientWithResponses) CancelDeploymentWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelDeploymentResponse, error) {
+	rsp, err := c.CancelDeployment(ctx, deploymentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelDeploymentResponse(rsp)
+}
+
+// GetDeploymentHealthWithResponse request returning *GetDeploymentHealthResponse
+func (c *ClientWithResponses) GetDeploymentHealthWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDeploymentHealthResponse, error) {
+	rsp, err := c.GetDeploymentHealth(ctx, deploymentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeploymentHealthResponse(rsp)
+}
+
+// GetEnvironmentComposeFileWithResponse request returning *GetEnvironmentComposeFileResponse
+func (c *ClientWithResponses) GetEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetEnvironmentComposeFileResponse, error) {
+	rsp, err := c.GetEnvironmentComposeFile(ctx, composeFileId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentComposeFileResponse(rsp)
+}
+
+// DeployEnvironmentComposeFileWithBodyWithResponse request with arbitrary body returning *DeployEnvironmentComposeFileResponse
+func (c *ClientWithResponses) DeployEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, composeFileId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error) {
+	rsp, err := c.DeployEnvironmentComposeFileWithBody(ctx, composeFileId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployEnvironmentComposeFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeployEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, body DeployEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error) {
+	rsp, err := c.DeployEnvironmentComposeFile(ctx, composeFileId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeployEnvironmentComposeFileResponse(rsp)
+}
+
+// GetOrganizationPlanWithResponse request returning *GetOrganizationPlanResponse
+func (c *ClientWithResponses) GetOrganizationPlanWithResponse(ctx context.Context, orgSlug string, reqEditors ...RequestEditorFn) (*GetOrganizationPlanResponse, error) {
+	rsp, err := c.GetOrganizationPlan(ctx, orgSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationPlanResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, orgSlug string, projectSlug string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, orgSlug, projectSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// CreateOrUpdateAppWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateAppResponse
+func (c *ClientWithResponses) CreateOrUpdateAppWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error) {
+	rsp, err := c.CreateOrUpdateAppWithBody(ctx, orgSlug, projectSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateAppResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrUpdateAppWithResponse(ctx context.Context, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error) {
+	rsp, err := c.CreateOrUpdateApp(ctx, orgSlug, projectSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateAppResponse(rsp)
+}
+
+// CreateOrUpdateEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateEnvironmentResponse
+func (c *ClientWithResponses) CreateOrUpdateEnvironmentWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error) {
+	rsp, err := c.CreateOrUpdateEnvironmentWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateOrUpdateEnvironmentWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error) {
+	rsp, err := c.CreateOrUpdateEnvironment(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateOrUpdateEnvironmentResponse(rsp)
+}
+
+// GetEnvironmentCanaryWithResponse request returning *GetEnvironmentCanaryResponse
+func (c *ClientWithResponses) GetEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetEnvironmentCanaryResponse, error) {
+	rsp, err := c.GetEnvironmentCanary(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentCanaryResponse(rsp)
+}
+
+// AbortEnvironmentCanaryWithResponse request returning *AbortEnvironmentCanaryResponse
+func (c *ClientWithResponses) AbortEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*AbortEnvironmentCanaryResponse, error) {
+	rsp, err := c.AbortEnvironmentCanary(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAbortEnvironmentCanaryResponse(rsp)
+}
+
+// PromoteEnvironmentCanaryWithBodyWithResponse request with arbitrary body returning *PromoteEnvironmentCanaryResponse
+func (c *ClientWithResponses) PromoteEnvironmentCanaryWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PromoteEnvironmentCanaryResponse, error) {
+	rsp, err := c.PromoteEnvironmentCanaryWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteEnvironmentCanaryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PromoteEnvironmentCanaryWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body PromoteEnvironmentCanaryJSONRequestBody, reqEditors ...RequestEditorFn) (*PromoteEnvironmentCanaryResponse, error) {
+	rsp, err := c.PromoteEnvironmentCanary(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePromoteEnvironmentCanaryResponse(rsp)
+}
+
+// CreateEnvironmentComposeFileWithBodyWithResponse request with arbitrary body returning *CreateEnvironmentComposeFileResponse
+func (c *ClientWithResponses) CreateEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error) {
+	rsp, err := c.CreateEnvironmentComposeFileWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentComposeFileResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error) {
+	rsp, err := c.CreateEnvironmentComposeFile(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateEnvironmentComposeFileResponse(rsp)
+}
+
+// GetDeployedEnvironmentComposeFileWithResponse request returning *GetDeployedEnvironmentComposeFileResponse
+func (c *ClientWithResponses) GetDeployedEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetDeployedEnvironmentComposeFileResponse, error) {
+	rsp, err := c.GetDeployedEnvironmentComposeFile(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDeployedEnvironmentComposeFileResponse(rsp)
+}
+
+// ListEnvironmentDeploymentsWithResponse request returning *ListEnvironmentDeploymentsResponse
+func (c *ClientWithResponses) ListEnvironmentDeploymentsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, params *ListEnvironmentDeploymentsParams, reqEditors ...RequestEditorFn) (*ListEnvironmentDeploymentsResponse, error) {
+	rsp, err := c.ListEnvironmentDeployments(ctx, orgSlug, projectSlug, envSlug, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEnvironmentDeploymentsResponse(rsp)
+}
+
+// ListEnvironmentDomainsWithResponse request returning *ListEnvironmentDomainsResponse
+func (c *ClientWithResponses) ListEnvironmentDomainsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*ListEnvironmentDomainsResponse, error) {
+	rsp, err := c.ListEnvironmentDomains(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEnvironmentDomainsResponse(rsp)
+}
+
+// SyncEnvironmentDomainsWithBodyWithResponse request with arbitrary body returning *SyncEnvironmentDomainsResponse
+func (c *ClientWithResponses) SyncEnvironmentDomainsWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncEnvironmentDomainsResponse, error) {
+	rsp, err := c.SyncEnvironmentDomainsWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncEnvironmentDomainsResponse(rsp)
+}
+
+func (c *ClientWithResponses) SyncEnvironmentDomainsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body SyncEnvironmentDomainsJSONRequestBody, reqEditors ...RequestEditorFn) (*SyncEnvironmentDomainsResponse, error) {
+	rsp, err := c.SyncEnvironmentDomains(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncEnvironmentDomainsResponse(rsp)
+}
+
+// VerifyEnvironmentDomainWithResponse request returning *VerifyEnvironmentDomainResponse
+func (c *ClientWithResponses) VerifyEnvironmentDomainWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, hostname string, reqEditors ...RequestEditorFn) (*VerifyEnvironmentDomainResponse, error) {
+	rsp, err := c.VerifyEnvironmentDomain(ctx, orgSlug, projectSlug, envSlug, hostname, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyEnvironmentDomainResponse(rsp)
+}
+
+// ListEnvironmentSecretsWithResponse request returning *ListEnvironmentSecretsResponse
+func (c *ClientWithResponses) ListEnvironmentSecretsWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*ListEnvironmentSecretsResponse, error) {
+	rsp, err := c.ListEnvironmentSecrets(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEnvironmentSecretsResponse(rsp)
+}
+
+// GetEnvironmentSecretsPublicKeyWithResponse request returning *GetEnvironmentSecretsPublicKeyResponse
+func (c *ClientWithResponses) GetEnvironmentSecretsPublicKeyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretsPublicKeyResponse, error) {
+	rsp, err := c.GetEnvironmentSecretsPublicKey(ctx, orgSlug, projectSlug, envSlug, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentSecretsPublicKeyResponse(rsp)
+}
+
+// DeleteEnvironmentSecretWithResponse request returning *DeleteEnvironmentSecretResponse
+func (c *ClientWithResponses) DeleteEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*DeleteEnvironmentSecretResponse, error) {
+	rsp, err := c.DeleteEnvironmentSecret(ctx, orgSlug, projectSlug, envSlug, secretName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteEnvironmentSecretResponse(rsp)
+}
+
+// GetEnvironmentSecretWithResponse request returning *GetEnvironmentSecretResponse
+func (c *ClientWithResponses) GetEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, reqEditors ...RequestEditorFn) (*GetEnvironmentSecretResponse, error) {
+	rsp, err := c.GetEnvironmentSecret(ctx, orgSlug, projectSlug, envSlug, secretName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEnvironmentSecretResponse(rsp)
+}
+
+// SetEnvironmentSecretWithBodyWithResponse request with arbitrary body returning *SetEnvironmentSecretResponse
+func (c *ClientWithResponses) SetEnvironmentSecretWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretResponse, error) {
+	rsp, err := c.SetEnvironmentSecretWithBody(ctx, orgSlug, projectSlug, envSlug, secretName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEnvironmentSecretResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetEnvironmentSecretWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, secretName string, body SetEnvironmentSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*SetEnvironmentSecretResponse, error) {
+	rsp, err := c.SetEnvironmentSecret(ctx, orgSlug, projectSlug, envSlug, secretName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetEnvironmentSecretResponse(rsp)
+}
+
+// GetApiV1WhoamiWithResponse request returning *GetApiV1WhoamiResponse
+func (c *ClientWithResponses) GetApiV1WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1WhoamiResponse, error) {
+	rsp, err := c.GetApiV1Whoami(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetApiV1WhoamiResponse(rsp)
+}
+
+// ParseLintComposeFileObjectResponse parses an HTTP response from a LintComposeFileObjectWithResponse call
+func ParseLintComposeFileObjectResponse(rsp *http.Response) (*LintComposeFileObjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LintComposeFileObjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Results []LintingIssue `json:"results"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentResponse parses an HTTP response from a GetDeploymentWithResponse call
+func ParseGetDeploymentResponse(rsp *http.Response) (*GetDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CreatedAt     time.Time          `json:"createdAt"`
+			EnvironmentId openapi_types.UUID `json:"environmentId"`
+			Id            openapi_types.UUID `json:"id"`
+			Logs          *[]struct {
+				Log       string    `json:"log"`
+				Stream    string    `json:"stream"`
+				Timestamp time.Time `json:"timestamp"`
+			} `json:"logs,omitempty"`
+			Status    string             `json:"status"`
+			UpdatedAt time.Time          `json:"updatedAt"`
+			VersionId openapi_types.UUID `json:"versionId"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelDeploymentResponse parses an HTTP response from a CancelDeploymentWithResponse call
+func ParseCancelDeploymentResponse(rsp *http.Response) (*CancelDeploymentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelDeploymentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Deployment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetDeploymentHealthResponse parses an HTTP response from a GetDeploymentHealthWithResponse call
+func ParseGetDeploymentHealthResponse(rsp *http.Response) (*GetDeploymentHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetDeploymentHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			DeploymentId openapi_types.UUID `json:"deploymentId"`
+
+			// Error Error message if health check failed
+			Error  *string `json:"error,omitempty"`
+			Health *struct {
+				Events *[]struct {
+					Count          *float32 `json:"count,omitempty"`
+					FirstTimestamp *string  `json:"firstTimestamp,omitempty"`
+					InvolvedObject *struct {
+						Kind *string `json:"kind,omitempty"`
+						Name *string `json:"name,omitempty"`
+					} `json:"involvedObject,omitempty"`
+					LastTimestamp *string `json:"lastTimestamp,omitempty"`
+					Message       *string `json:"message,omitempty"`
+					Reason        *string `json:"reason,omitempty"`
+					Source        *string `json:"source,omitempty"`
+					Type          *string `json:"type,omitempty"`
+				} `json:"events,omitempty"`
+				Pods *[]struct {
+					Age        *string `json:"age,omitempty"`
+					Conditions *[]struct {
+						LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
+						Message            *string `json:"message,omitempty"`
+						Reason             *string `json:"reason,omitempty"`
+						Status             *string `json:"status,omitempty"`
+						Type               *string `json:"type,omitempty"`
+					} `json:"conditions,omitempty"`
+					ContainerStatuses *[]struct {
+						LastState    *map[string]interface{} `json:"lastState,omitempty"`
+						Name         *string                 `json:"name,omitempty"`
+						Ready        *bool                   `json:"ready,omitempty"`
+						RestartCount *float32                `json:"restartCount,omitempty"`
+						State        *map[string]interface{} `json:"state,omitempty"`
+					} `json:"containerStatuses,omitempty"`
+					Ip       *string  `json:"ip,omitempty"`
+					Name     *string  `json:"name,omitempty"`
+					Node     *string  `json:"node,omitempty"`
+					Phase    *string  `json:"phase,omitempty"`
+					Ready    *bool    `json:"ready,omitempty"`
+					Restarts *float32 `json:"restarts,omitempty"`
+				} `json:"pods,omitempty"`
+				Resources *struct {
+					Deployments *[]struct {
+						Available  *float32 `json:"available,omitempty"`
+						Conditions *[]struct {
+							Message *string `json:"message,omitempty"`
+							Reason  *string `json:"reason,omitempty"`
+							Status  *string `json:"status,omitempty"`
+							Type    *string `json:"type,omitempty"`
+						} `json:"conditions,omitempty"`
+						Desired *float32 `json:"desired,omitempty"`
+						Name    *string  `json:"name,omitempty"`
+						Ready   *float32 `json:"ready,omitempty"`
+						Updated *float32 `json:"updated,omitempty"`
+					} `json:"deployments,omitempty"`
+					Ingresses *[]struct {
+						Hosts *[]string `json:"hosts,omitempty"`
+						Name  *string   `json:"name,omitempty"`
+						Ready *bool     `json:"ready,omitempty"`
+					} `json:"ingresses,omitempty"`
+					Services *[]struct {
+						ClusterIP *string `json:"clusterIP,omitempty"`
+						Name      *string `json:"name,omitempty"`
+						Ports     *[]struct {
+							Port     *float32 `json:"port,omitempty"`
+							Protocol *string  `json:"protocol,omitempty"`
+
+							// TargetPort Port number or name that containers expose (can be numeric string like '8080' or named port like 'http')
+							TargetPort *string `json:"targetPort,omitempty"`
+						} `json:"ports,omitempty"`
+						Type *string `json:"type,omitempty"`
+					} `json:"services,omitempty"`
+				} `json:"resources,omitempty"`
+			} `json:"health,omitempty"`
+
+			// HealthScore Health score from 0-100
+			HealthScore     float32                      `json:"healthScore"`
+			LastHealthCheck *time.Time                   `json:"lastHealthCheck,omitempty"`
+			Status          GetDeploymentHealth200Status `json:"status"`
+
+			// Summary Human-readable health summary
+			Summary         string `json:"summary"`
+			Troubleshooting *struct {
+				HealthScore   *float32  `json:"healthScore,omitempty"`
+				QuickCommands *[]string `json:"quickCommands,omitempty"`
+				Suggestions   *[]string `json:"suggestions,omitempty"`
+				Summary       *string   `json:"summary,omitempty"`
+			} `json:"troubleshooting,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentComposeFileResponse parses an HTTP response from a GetEnvironmentComposeFileWithResponse call
+func ParseGetEnvironmentComposeFileResponse(rsp *http.Response) (*GetEnvironmentComposeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentComposeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentComposeFile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeployEnvironmentComposeFileResponse parses an HTTP response from a DeployEnvironmentComposeFileWithResponse call
+func ParseDeployEnvironmentComposeFileResponse(rsp *http.Response) (*DeployEnvironmentComposeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeployEnvironmentComposeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Deployments []struct {
+				// CreatedAt Creation timestamp
+				CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+				// EnvironmentComposeFileId The compose file ID
+				EnvironmentComposeFileId *openapi_types.UUID `json:"environmentComposeFileId,omitempty"`
+
+				// EnvironmentId The environment ID
+				EnvironmentId *openapi_types.UUID `json:"environmentId,omitempty"`
+
+				// Id The deployment ID
+				Id *openapi_types.UUID `json:"id,omitempty"`
+
+				// Status The deployment status
+				Status *string `json:"status,omitempty"`
+
+				// UpdatedAt Last update timestamp
+				UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+			} `json:"deployments"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationPlanResponse parses an HTTP response from a GetOrganizationPlanWithResponse call
+func ParseGetOrganizationPlanResponse(rsp *http.Response) (*GetOrganizationPlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationPlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PlanLimits
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CreatedAt    time.Time `json:"createdAt"`
+			Description  *string   `json:"description,omitempty"`
+			Environments *[]struct {
+				ComposeFiles *[]EnvironmentComposeFile `json:"composeFiles,omitempty"`
+				CreatedAt    time.Time                 `json:"createdAt"`
+				Id           openapi_types.UUID        `json:"id"`
+				Name         string                    `json:"name"`
+				Slug         string                    `json:"slug"`
+				UpdatedAt    time.Time                 `json:"updatedAt"`
+			} `json:"environments,omitempty"`
+			Id        openapi_types.UUID `json:"id"`
+			Name      string             `json:"name"`
+			Slug      string             `json:"slug"`
+			UpdatedAt time.Time          `json:"updatedAt"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrUpdateAppResponse parses an HTTP response from a CreateOrUpdateAppWithResponse call
+func ParseCreateOrUpdateAppResponse(rsp *http.Response) (*CreateOrUpdateAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrUpdateAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			CreatedAt    time.Time          `json:"createdAt"`
+			Description  *string            `json:"description,omitempty"`
+			Environments *[]Environment     `json:"environments,omitempty"`
+			Id           openapi_types.UUID `json:"id"`
+			Name         string             `json:"name"`
+			Organization *Organization      `json:"organization,omitempty"`
+			Slug         string             `json:"slug"`
+			UpdatedAt    time.Time          `json:"updatedAt"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateOrUpdateEnvironmentResponse parses an HTTP response from a CreateOrUpdateEnvironmentWithResponse call
+func ParseCreateOrUpdateEnvironmentResponse(rsp *http.Response) (*CreateOrUpdateEnvironmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateOrUpdateEnvironmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Environment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentCanaryResponse parses an HTTP response from a GetEnvironmentCanaryWithResponse call
+func ParseGetEnvironmentCanaryResponse(rsp *http.Response) (*GetEnvironmentCanaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentCanaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetApiV1WhoamiResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// LintComposeFileObjectWithBodyWithResponse request with arbitrary body returning *LintComposeFileObjectResponse
-func (c *ClientWithResponses) LintComposeFileObjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error) {
-	rsp, err := c.LintComposeFileObjectWithBody(ctx, contentType, body, reqEditors...)
+// ParseAbortEnvironmentCanaryResponse parses an HTTP response from a AbortEnvironmentCanaryWithResponse call
+func ParseAbortEnvironmentCanaryResponse(rsp *http.Response) (*AbortEnvironmentCanaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLintComposeFileObjectResponse(rsp)
-}
 
-func (c *ClientWithResponses) LintComposeFileObjectWithResponse(ctx context.Context, body LintComposeFileObjectJSONRequestBody, reqEditors ...RequestEditorFn) (*LintComposeFileObjectResponse, error) {
-	rsp, err := c.LintComposeFileObject(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AbortEnvironmentCanaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseLintComposeFileObjectResponse(rsp)
-}
 
-// GetDeploymentWithResponse request returning *GetDeploymentResponse
-func (c *ClientWithResponses) GetDeploymentWithResponse(ctx context.Context, deploymentId string, reqEditors ...RequestEditorFn) (*GetDeploymentResponse, error) {
-	rsp, err := c.GetDeployment(ctx, deploymentId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseGetDeploymentResponse(rsp)
+
+	return response, nil
 }
 
-// GetDeploymentHealthWithResponse request returning *GetDeploymentHealthResponse
-func (c *ClientWithResponses) GetDeploymentHealthWithResponse(ctx context.Context, deploymentId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetDeploymentHealthResponse, error) {
-	rsp, err := c.GetDeploymentHealth(ctx, deploymentId, reqEditors...)
+// ParsePromoteEnvironmentCanaryResponse parses an HTTP response from a PromoteEnvironmentCanaryWithResponse call
+func ParsePromoteEnvironmentCanaryResponse(rsp *http.Response) (*PromoteEnvironmentCanaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetDeploymentHealthResponse(rsp)
-}
 
-// DeployEnvironmentComposeFileWithResponse request returning *DeployEnvironmentComposeFileResponse
-func (c *ClientWithResponses) DeployEnvironmentComposeFileWithResponse(ctx context.Context, composeFileId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeployEnvironmentComposeFileResponse, error) {
-	rsp, err := c.DeployEnvironmentComposeFile(ctx, composeFileId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PromoteEnvironmentCanaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeployEnvironmentComposeFileResponse(rsp)
-}
 
-// GetProjectWithResponse request returning *GetProjectResponse
-func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, orgSlug string, projectSlug string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
-	rsp, err := c.GetProject(ctx, orgSlug, projectSlug, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CanaryRollout
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseGetProjectResponse(rsp)
+
+	return response, nil
 }
 
-// CreateOrUpdateAppWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateAppResponse
-func (c *ClientWithResponses) CreateOrUpdateAppWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error) {
-	rsp, err := c.CreateOrUpdateAppWithBody(ctx, orgSlug, projectSlug, contentType, body, reqEditors...)
+// ParseCreateEnvironmentComposeFileResponse parses an HTTP response from a CreateEnvironmentComposeFileWithResponse call
+func ParseCreateEnvironmentComposeFileResponse(rsp *http.Response) (*CreateEnvironmentComposeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrUpdateAppResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateOrUpdateAppWithResponse(ctx context.Context, orgSlug string, projectSlug string, body CreateOrUpdateAppJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateAppResponse, error) {
-	rsp, err := c.CreateOrUpdateApp(ctx, orgSlug, projectSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateEnvironmentComposeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateOrUpdateAppResponse(rsp)
-}
 
-// CreateOrUpdateEnvironmentWithBodyWithResponse request with arbitrary body returning *CreateOrUpdateEnvironmentResponse
-func (c *ClientWithResponses) CreateOrUpdateEnvironmentWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error) {
-	rsp, err := c.CreateOrUpdateEnvironmentWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Compose       map[string]interface{} `json:"compose"`
+			CreatedAt     time.Time              `json:"createdAt"`
+			Environment   *Environment           `json:"environment,omitempty"`
+			EnvironmentId openapi_types.UUID     `json:"environmentId"`
+			Id            openapi_types.UUID     `json:"id"`
+			RawCompose    string                 `json:"rawCompose"`
+			UpdatedAt     time.Time              `json:"updatedAt"`
+			Version       string                 `json:"version"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ZodError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCreateOrUpdateEnvironmentResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateOrUpdateEnvironmentWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateOrUpdateEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateOrUpdateEnvironmentResponse, error) {
-	rsp, err := c.CreateOrUpdateEnvironment(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
+// ParseGetDeployedEnvironmentComposeFileResponse parses an HTTP response from a GetDeployedEnvironmentComposeFileWithResponse call
+func ParseGetDeployedEnvironmentComposeFileResponse(rsp *http.Response) (*GetDeployedEnvironmentComposeFileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateOrUpdateEnvironmentResponse(rsp)
+
+	response := &GetDeployedEnvironmentComposeFileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentComposeFile
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreateEnvironmentComposeFileWithBodyWithResponse request with arbitrary body returning *CreateEnvironmentComposeFileResponse
-func (c *ClientWithResponses) CreateEnvironmentComposeFileWithBodyWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error) {
-	rsp, err := c.CreateEnvironmentComposeFileWithBody(ctx, orgSlug, projectSlug, envSlug, contentType, body, reqEditors...)
+// ParseListEnvironmentDeploymentsResponse parses an HTTP response from a ListEnvironmentDeploymentsWithResponse call
+func ParseListEnvironmentDeploymentsResponse(rsp *http.Response) (*ListEnvironmentDeploymentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateEnvironmentComposeFileResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateEnvironmentComposeFileWithResponse(ctx context.Context, orgSlug string, projectSlug string, envSlug string, body CreateEnvironmentComposeFileJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateEnvironmentComposeFileResponse, error) {
-	rsp, err := c.CreateEnvironmentComposeFile(ctx, orgSlug, projectSlug, envSlug, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateEnvironmentComposeFileResponse(rsp)
-}
+	response := &ListEnvironmentDeploymentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DeploymentSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// GetApiV1WhoamiWithResponse request returning *GetApiV1WhoamiResponse
-func (c *ClientWithResponses) GetApiV1WhoamiWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetApiV1WhoamiResponse, error) {
-	rsp, err := c.GetApiV1Whoami(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetApiV1WhoamiResponse(rsp)
+
+	return response, nil
 }
 
-// ParseLintComposeFileObjectResponse parses an HTTP response from a LintComposeFileObjectWithResponse call
-func ParseLintComposeFileObjectResponse(rsp *http.Response) (*LintComposeFileObjectResponse, error) {
+// ParseListEnvironmentDomainsResponse parses an HTTP response from a ListEnvironmentDomainsWithResponse call
+func ParseListEnvironmentDomainsResponse(rsp *http.Response) (*ListEnvironmentDomainsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LintComposeFileObjectResponse{
+	response := &ListEnvironmentDomainsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1418,45 +4311,45 @@ func ParseLintComposeFileObjectResponse(rsp *http.Response) (*LintComposeFileObj
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Results []LintingIssue `json:"results"`
+			Domains []Domain `json:"domains"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetDeploymentResponse parses an HTTP response from a GetDeploymentWithResponse call
-func ParseGetDeploymentResponse(rsp *http.Response) (*GetDeploymentResponse, error) {
+// ParseSyncEnvironmentDomainsResponse parses an HTTP response from a SyncEnvironmentDomainsWithResponse call
+func ParseSyncEnvironmentDomainsResponse(rsp *http.Response) (*SyncEnvironmentDomainsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeploymentResponse{
+	response := &SyncEnvironmentDomainsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1464,17 +4357,7 @@ func ParseGetDeploymentResponse(rsp *http.Response) (*GetDeploymentResponse, err
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			CreatedAt     time.Time          `json:"createdAt"`
-			EnvironmentId openapi_types.UUID `json:"environmentId"`
-			Id            openapi_types.UUID `json:"id"`
-			Logs          *[]struct {
-				Log       string    `json:"log"`
-				Stream    string    `json:"stream"`
-				Timestamp time.Time `json:"timestamp"`
-			} `json:"logs,omitempty"`
-			Status    string             `json:"status"`
-			UpdatedAt time.Time          `json:"updatedAt"`
-			VersionId openapi_types.UUID `json:"versionId"`
+			Domains []Domain `json:"domains"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
@@ -1511,130 +4394,59 @@ func ParseGetDeploymentResponse(rsp *http.Response) (*GetDeploymentResponse, err
 	return response, nil
 }
 
-// ParseGetDeploymentHealthResponse parses an HTTP response from a GetDeploymentHealthWithResponse call
-func ParseGetDeploymentHealthResponse(rsp *http.Response) (*GetDeploymentHealthResponse, error) {
+// ParseVerifyEnvironmentDomainResponse parses an HTTP response from a VerifyEnvironmentDomainWithResponse call
+func ParseVerifyEnvironmentDomainResponse(rsp *http.Response) (*VerifyEnvironmentDomainResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetDeploymentHealthResponse{
+	response := &VerifyEnvironmentDomainResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			DeploymentId openapi_types.UUID `json:"deploymentId"`
-
-			// Error Error message if health check failed
-			Error  *string `json:"error,omitempty"`
-			Health *struct {
-				Events *[]struct {
-					Count          *float32 `json:"count,omitempty"`
-					FirstTimestamp *string  `json:"firstTimestamp,omitempty"`
-					InvolvedObject *struct {
-						Kind *string `json:"kind,omitempty"`
-						Name *string `json:"name,omitempty"`
-					} `json:"involvedObject,omitempty"`
-					LastTimestamp *string `json:"lastTimestamp,omitempty"`
-					Message       *string `json:"message,omitempty"`
-					Reason        *string `json:"reason,omitempty"`
-					Source        *string `json:"source,omitempty"`
-					Type          *string `json:"type,omitempty"`
-				} `json:"events,omitempty"`
-				Pods *[]struct {
-					Age        *string `json:"age,omitempty"`
-					Conditions *[]struct {
-						LastTransitionTime *string `json:"lastTransitionTime,omitempty"`
-						Message            *string `json:"message,omitempty"`
-						Reason             *string `json:"reason,omitempty"`
-						Status             *string `json:"status,omitempty"`
-						Type               *string `json:"type,omitempty"`
-					} `json:"conditions,omitempty"`
-					ContainerStatuses *[]struct {
-						LastState    *map[string]interface{} `json:"lastState,omitempty"`
-						Name         *string                 `json:"name,omitempty"`
-						Ready        *bool                   `json:"ready,omitempty"`
-						RestartCount *float32                `json:"restartCount,omitempty"`
-						State        *map[string]interface{} `json:"state,omitempty"`
-					} `json:"containerStatuses,omitempty"`
-					Ip       *string  `json:"ip,omitempty"`
-					Name     *string  `json:"name,omitempty"`
-					Node     *string  `json:"node,omitempty"`
-					Phase    *string  `json:"phase,omitempty"`
-					Ready    *bool    `json:"ready,omitempty"`
-					Restarts *float32 `json:"restarts,omitempty"`
-				} `json:"pods,omitempty"`
-				Resources *struct {
-					Deployments *[]struct {
-						Available  *float32 `json:"available,omitempty"`
-						Conditions *[]struct {
-							Message *string `json:"message,omitempty"`
-							Reason  *string `json:"reason,omitempty"`
-							Status  *string `json:"status,omitempty"`
-							Type    *string `json:"type,omitempty"`
-						} `json:"conditions,omitempty"`
-						Desired *float32 `json:"desired,omitempty"`
-						Name    *string  `json:"name,omitempty"`
-						Ready   *float32 `json:"ready,omitempty"`
-						Updated *float32 `json:"updated,omitempty"`
-					} `json:"deployments,omitempty"`
-					Ingresses *[]struct {
-						Hosts *[]string `json:"hosts,omitempty"`
-						Name  *string   `json:"name,omitempty"`
-						Ready *bool     `json:"ready,omitempty"`
-					} `json:"ingresses,omitempty"`
-					Services *[]struct {
-						ClusterIP *string `json:"clusterIP,omitempty"`
-						Name      *string `json:"name,omitempty"`
-						Ports     *[]struct {
-							Port     *float32 `json:"port,omitempty"`
-							Protocol *string  `json:"protocol,omitempty"`
-
-							// TargetPort Port number or name that containers expose (can be numeric string like '8080' or named port like 'http')
-							TargetPort *string `json:"targetPort,omitempty"`
-						} `json:"ports,omitempty"`
-						Type *string `json:"type,omitempty"`
-					} `json:"services,omitempty"`
-				} `json:"resources,omitempty"`
-			} `json:"health,omitempty"`
+		var dest Domain
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-			// HealthScore Health score from 0-100
-			HealthScore     float32                      `json:"healthScore"`
-			LastHealthCheck *time.Time                   `json:"lastHealthCheck,omitempty"`
-			Status          GetDeploymentHealth200Status `json:"status"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
 
-			// Summary Human-readable health summary
-			Summary         string `json:"summary"`
-			Troubleshooting *struct {
-				HealthScore   *float32  `json:"healthScore,omitempty"`
-				QuickCommands *[]string `json:"quickCommands,omitempty"`
-				Suggestions   *[]string `json:"suggestions,omitempty"`
-				Summary       *string   `json:"summary,omitempty"`
-			} `json:"troubleshooting,omitempty"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest struct {
+			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeployEnvironmentComposeFileResponse parses an HTTP response from a DeployEnvironmentComposeFileWithResponse call
-func ParseDeployEnvironmentComposeFileResponse(rsp *http.Response) (*DeployEnvironmentComposeFileResponse, error) {
+// ParseListEnvironmentSecretsResponse parses an HTTP response from a ListEnvironmentSecretsWithResponse call
+func ParseListEnvironmentSecretsResponse(rsp *http.Response) (*ListEnvironmentSecretsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeployEnvironmentComposeFileResponse{
+	response := &ListEnvironmentSecretsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1642,38 +4454,13 @@ func ParseDeployEnvironmentComposeFileResponse(rsp *http.Response) (*DeployEnvir
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
-			Deployments []struct {
-				// CreatedAt Creation timestamp
-				CreatedAt *time.Time `json:"createdAt,omitempty"`
-
-				// EnvironmentComposeFileId The compose file ID
-				EnvironmentComposeFileId *openapi_types.UUID `json:"environmentComposeFileId,omitempty"`
-
-				// EnvironmentId The environment ID
-				EnvironmentId *openapi_types.UUID `json:"environmentId,omitempty"`
-
-				// Id The deployment ID
-				Id *openapi_types.UUID `json:"id,omitempty"`
-
-				// Status The deployment status
-				Status *string `json:"status,omitempty"`
-
-				// UpdatedAt Last update timestamp
-				UpdatedAt *time.Time `json:"updatedAt,omitempty"`
-			} `json:"deployments"`
+			Secrets []Secret `json:"secrets"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ZodError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -1697,37 +4484,22 @@ func ParseDeployEnvironmentComposeFileResponse(rsp *http.Response) (*DeployEnvir
 	return response, nil
 }
 
-// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
-func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+// ParseGetEnvironmentSecretsPublicKeyResponse parses an HTTP response from a GetEnvironmentSecretsPublicKeyWithResponse call
+func ParseGetEnvironmentSecretsPublicKeyResponse(rsp *http.Response) (*GetEnvironmentSecretsPublicKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetProjectResponse{
+	response := &GetEnvironmentSecretsPublicKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			CreatedAt    time.Time `json:"createdAt"`
-			Description  *string   `json:"description,omitempty"`
-			Environments *[]struct {
-				ComposeFiles *[]EnvironmentComposeFile `json:"composeFiles,omitempty"`
-				CreatedAt    time.Time                 `json:"createdAt"`
-				Id           openapi_types.UUID        `json:"id"`
-				Name         string                    `json:"name"`
-				Slug         string                    `json:"slug"`
-				UpdatedAt    time.Time                 `json:"updatedAt"`
-			} `json:"environments,omitempty"`
-			Id        openapi_types.UUID `json:"id"`
-			Name      string             `json:"name"`
-			Slug      string             `json:"slug"`
-			UpdatedAt time.Time          `json:"updatedAt"`
-		}
+		var dest SecretPublicKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1756,85 +4528,64 @@ func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
 	return response, nil
 }
 
-// ParseCreateOrUpdateAppResponse parses an HTTP response from a CreateOrUpdateAppWithResponse call
-func ParseCreateOrUpdateAppResponse(rsp *http.Response) (*CreateOrUpdateAppResponse, error) {
+// ParseDeleteEnvironmentSecretResponse parses an HTTP response from a DeleteEnvironmentSecretWithResponse call
+func ParseDeleteEnvironmentSecretResponse(rsp *http.Response) (*DeleteEnvironmentSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateOrUpdateAppResponse{
+	response := &DeleteEnvironmentSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
-			CreatedAt    time.Time          `json:"createdAt"`
-			Description  *string            `json:"description,omitempty"`
-			Environments *[]Environment     `json:"environments,omitempty"`
-			Id           openapi_types.UUID `json:"id"`
-			Name         string             `json:"name"`
-			Organization *Organization      `json:"organization,omitempty"`
-			Slug         string             `json:"slug"`
-			UpdatedAt    time.Time          `json:"updatedAt"`
-		}
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+			Error string `json:"error"`
 		}
-		response.JSON200 = &dest
-
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ZodError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest struct {
 			Error string `json:"error"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateOrUpdateEnvironmentResponse parses an HTTP response from a CreateOrUpdateEnvironmentWithResponse call
-func ParseCreateOrUpdateEnvironmentResponse(rsp *http.Response) (*CreateOrUpdateEnvironmentResponse, error) {
+// ParseGetEnvironmentSecretResponse parses an HTTP response from a GetEnvironmentSecretWithResponse call
+func ParseGetEnvironmentSecretResponse(rsp *http.Response) (*GetEnvironmentSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateOrUpdateEnvironmentResponse{
+	response := &GetEnvironmentSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest Secret
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ZodError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest struct {
 			Error string `json:"error"`
@@ -1858,31 +4609,22 @@ func ParseCreateOrUpdateEnvironmentResponse(rsp *http.Response) (*CreateOrUpdate
 	return response, nil
 }
 
-// ParseCreateEnvironmentComposeFileResponse parses an HTTP response from a CreateEnvironmentComposeFileWithResponse call
-func ParseCreateEnvironmentComposeFileResponse(rsp *http.Response) (*CreateEnvironmentComposeFileResponse, error) {
+// ParseSetEnvironmentSecretResponse parses an HTTP response from a SetEnvironmentSecretWithResponse call
+func ParseSetEnvironmentSecretResponse(rsp *http.Response) (*SetEnvironmentSecretResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEnvironmentComposeFileResponse{
+	response := &SetEnvironmentSecretResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Compose       map[string]interface{} `json:"compose"`
-			CreatedAt     time.Time              `json:"createdAt"`
-			Environment   *Environment           `json:"environment,omitempty"`
-			EnvironmentId openapi_types.UUID     `json:"environmentId"`
-			Id            openapi_types.UUID     `json:"id"`
-			RawCompose    string                 `json:"rawCompose"`
-			UpdatedAt     time.Time              `json:"updatedAt"`
-			Version       string                 `json:"version"`
-		}
+		var dest Secret
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1,21 +1,21 @@
 package compose
 
 import (
-	"context"
-
-	"github.com/compose-spec/compose-go/v2/cli"
-	"github.com/compose-spec/compose-go/v2/types"
+	"os"
+	"path/filepath"
 )
 
-func LoadComposeConfig(configs []string) (*types.Project, error) {
-	opts, err := cli.NewProjectOptions(
-		configs,
-		cli.WithOsEnv,
-		cli.WithDotEnv,
-	)
-	if err != nil {
-		return nil, err
-	}
+// DefaultFiles are the compose file names looked up in a directory
+var DefaultFiles = []string{"compose.yaml", "compose.yml", "docker-compose.yaml", "docker-compose.yml"}
 
-	return opts.LoadProject(context.Background())
+// FindComposeFile returns the first default compose file in dir, or an
+// empty string when there is none
+func FindComposeFile(dir string) string {
+	for _, name := range DefaultFiles {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
 }
@@ -0,0 +1,292 @@
+package compose
+
+import (
+	"cli/pkg/secrets"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// ChangeKind says how a service or value changed between two versions
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// FieldChange is a change to one setting of a service. Environment values
+// are masked.
+type FieldChange struct {
+	Field string
+	Kind  ChangeKind
+	Old   string
+	New   string
+}
+
+// ServiceDiff lists the changes to one service
+type ServiceDiff struct {
+	Name    string
+	Kind    ChangeKind
+	Changes []FieldChange
+}
+
+// ProjectDiff is a semantic, per-service diff between two compose versions
+type ProjectDiff struct {
+	Services []ServiceDiff
+}
+
+// Empty reports whether the two versions deploy the same services
+func (d *ProjectDiff) Empty() bool {
+	return len(d.Services) == 0
+}
+
+// fields compared one by one; any other change is reported as a whole
+var diffedFields = []string{"image", "environment", "ports", "volumes", "deploy"}
+
+// NormalizeProject converts a project to the normalized JSON form stored
+// with every deployed compose file, with its x-portway extensions
+// normalized
+func NormalizeProject(project *types.Project) (map[string]any, error) {
+	if err := NormalizeExtensions(project); err != nil {
+		return nil, err
+	}
+
+	data, err := project.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal compose config: %w", err)
+	}
+
+	normalized := make(map[string]any)
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal compose config: %w", err)
+	}
+
+	return normalized, nil
+}
+
+// Diff compares two normalized compose files per service: images,
+// environment variables, ports, volumes and replicas, along with added and
+// removed services
+func Diff(old, new map[string]any) *ProjectDiff {
+	oldServices := asMap(old["services"])
+	newServices := asMap(new["services"])
+
+	diff := &ProjectDiff{}
+	for _, name := range unionKeys(oldServices, newServices) {
+		oldService, inOld := oldServices[name]
+		newService, inNew := newServices[name]
+
+		switch {
+		case !inOld:
+			diff.Services = append(diff.Services, ServiceDiff{
+				Name:    name,
+				Kind:    ChangeAdded,
+				Changes: diffService(nil, asMap(newService)),
+			})
+		case !inNew:
+			diff.Services = append(diff.Services, ServiceDiff{Name: name, Kind: ChangeRemoved})
+		default:
+			changes := diffService(asMap(oldService), asMap(newService))
+			if len(changes) > 0 {
+				diff.Services = append(diff.Services, ServiceDiff{Name: name, Kind: ChangeChanged, Changes: changes})
+			}
+		}
+	}
+
+	return diff
+}
+
+func diffService(old, new map[string]any) []FieldChange {
+	var changes []FieldChange
+
+	if change, ok := diffValue("image", asString(old["image"]), asString(new["image"])); ok {
+		changes = append(changes, change)
+	}
+
+	oldEnv := asMap(old["environment"])
+	newEnv := asMap(new["environment"])
+	for _, key := range unionKeys(oldEnv, newEnv) {
+		oldValue, inOld := oldEnv[key]
+		newValue, inNew := newEnv[key]
+		if inOld && inNew && reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		change := FieldChange{Field: "environment." + key, Kind: ChangeChanged}
+		if inOld {
+			change.Old = secrets.Mask(asString(oldValue))
+		} else {
+			change.Kind = ChangeAdded
+		}
+		if inNew {
+			change.New = secrets.Mask(asString(newValue))
+		} else {
+			change.Kind = ChangeRemoved
+		}
+		changes = append(changes, change)
+	}
+
+	changes = append(changes, diffSet("ports", formatList(old["ports"], formatPort), formatList(new["ports"], formatPort))...)
+	changes = append(changes, diffSet("volumes", formatList(old["volumes"], formatVolume), formatList(new["volumes"], formatVolume))...)
+
+	oldReplicas, newReplicas := replicas(old), replicas(new)
+	switch {
+	case old == nil && newReplicas != 1:
+		changes = append(changes, FieldChange{Field: "replicas", Kind: ChangeAdded, New: fmt.Sprint(newReplicas)})
+	case old != nil && oldReplicas != newReplicas:
+		changes = append(changes, FieldChange{Field: "replicas", Kind: ChangeChanged, Old: fmt.Sprint(oldReplicas), New: fmt.Sprint(newReplicas)})
+	}
+
+	if old != nil {
+		if other := otherChanges(old, new); len(other) > 0 {
+			changes = append(changes, FieldChange{Field: "other", Kind: ChangeChanged, New: strings.Join(other, ", ")})
+		}
+	}
+
+	return changes
+}
+
+func diffValue(field, old, new string) (FieldChange, bool) {
+	switch {
+	case old == new:
+		return FieldChange{}, false
+	case old == "":
+		return FieldChange{Field: field, Kind: ChangeAdded, New: new}, true
+	case new == "":
+		return FieldChange{Field: field, Kind: ChangeRemoved, Old: old}, true
+	default:
+		return FieldChange{Field: field, Kind: ChangeChanged, Old: old, New: new}, true
+	}
+}
+
+// diffSet reports values added to or removed from an unordered list
+func diffSet(field string, old, new []string) []FieldChange {
+	oldSet := make(map[string]bool, len(old))
+	for _, value := range old {
+		oldSet[value] = true
+	}
+	newSet := make(map[string]bool, len(new))
+	for _, value := range new {
+		newSet[value] = true
+	}
+
+	var changes []FieldChange
+	for _, value := range old {
+		if !newSet[value] {
+			changes = append(changes, FieldChange{Field: field, Kind: ChangeRemoved, Old: value})
+		}
+	}
+	for _, value := range new {
+		if !oldSet[value] {
+			changes = append(changes, FieldChange{Field: field, Kind: ChangeAdded, New: value})
+		}
+	}
+	return changes
+}
+
+// otherChanges lists the settings outside diffedFields that differ
+func otherChanges(old, new map[string]any) []string {
+	var other []string
+	for _, key := range unionKeys(old, new) {
+		if slices.Contains(diffedFields, key) {
+			continue
+		}
+		if !reflect.DeepEqual(old[key], new[key]) {
+			other = append(other, key)
+		}
+	}
+
+	// Deploy settings other than replicas
+	oldDeploy, newDeploy := asMap(old["deploy"]), asMap(new["deploy"])
+	for _, key := range unionKeys(oldDeploy, newDeploy) {
+		if key != "replicas" && !reflect.DeepEqual(oldDeploy[key], newDeploy[key]) {
+			other = append(other, "deploy."+key)
+		}
+	}
+
+	return other
+}
+
+func replicas(service map[string]any) int {
+	deploy := asMap(service["deploy"])
+	if value, ok := deploy["replicas"].(float64); ok {
+		return int(value)
+	}
+	return 1
+}
+
+func formatPort(value any) string {
+	port := asMap(value)
+	target := asString(port["target"])
+	published := asString(port["published"])
+	protocol := asString(port["protocol"])
+
+	formatted := target
+	if published != "" {
+		formatted = published + ":" + target
+	}
+	if protocol != "" && protocol != "tcp" {
+		formatted += "/" + protocol
+	}
+	return formatted
+}
+
+func formatVolume(value any) string {
+	volume := asMap(value)
+	formatted := asString(volume["target"])
+	if source := asString(volume["source"]); source != "" {
+		formatted = source + ":" + formatted
+	}
+	if readOnly, _ := volume["read_only"].(bool); readOnly {
+		formatted += ":ro"
+	}
+	return formatted
+}
+
+func formatList(value any, format func(any) string) []string {
+	list, _ := value.([]any)
+	formatted := make([]string, 0, len(list))
+	for _, item := range list {
+		formatted = append(formatted, format(item))
+	}
+	return formatted
+}
+
+func asMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
+
+func asString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%g", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
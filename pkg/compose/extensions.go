@@ -0,0 +1,170 @@
+package compose
+
+import (
+	"cli/pkg/probe"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// PortwayExtensionKey is the compose extension holding Portway settings
+const PortwayExtensionKey = "x-portway"
+
+// PortwayExtension is the top-level x-portway compose extension
+type PortwayExtension struct {
+	Readiness *probe.Settings `json:"readiness,omitempty"`
+}
+
+// ServiceExtension is the x-portway compose extension of a service
+type ServiceExtension struct {
+	Endpoints   []Endpoint   `json:"endpoints,omitempty"`
+	Domains     []string     `json:"domains,omitempty"`
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+	Health      *Health      `json:"health,omitempty"`
+}
+
+// Endpoint exposes a container port publicly under a path
+type Endpoint struct {
+	Port uint32 `json:"port"`
+	Path string `json:"path,omitempty"`
+}
+
+// Autoscaling scales a service between replica counts on CPU or memory
+// utilization, in percent
+type Autoscaling struct {
+	MinReplicas  int `json:"min-replicas,omitempty"`
+	MaxReplicas  int `json:"max-replicas"`
+	TargetCPU    int `json:"target-cpu,omitempty"`
+	TargetMemory int `json:"target-memory,omitempty"`
+}
+
+// Health declares the paths of the container health probes
+type Health struct {
+	Port      uint32 `json:"port,omitempty"`
+	Readiness string `json:"readiness,omitempty"`
+	Liveness  string `json:"liveness,omitempty"`
+}
+
+// GetPortwayExtension decodes the top-level x-portway extension of a project.
+// It returns an empty extension when the project does not declare one.
+func GetPortwayExtension(project *types.Project) (*PortwayExtension, error) {
+	ext := &PortwayExtension{}
+	if err := decodeExtension(project.Extensions, ext); err != nil {
+		return nil, err
+	}
+	return ext, nil
+}
+
+// GetServiceExtension decodes the x-portway extension of a service. It
+// returns an empty extension when the service does not declare one.
+func GetServiceExtension(service types.ServiceConfig) (*ServiceExtension, error) {
+	ext := &ServiceExtension{}
+	if err := decodeExtension(service.Extensions, ext); err != nil {
+		return nil, fmt.Errorf("service %s: %w", service.Name, err)
+	}
+	return ext, nil
+}
+
+func decodeExtension(extensions types.Extensions, ext any) error {
+	raw, ok := extensions[PortwayExtensionKey]
+	if !ok || raw == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+
+	if err := json.Unmarshal(data, ext); err != nil {
+		return fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+
+	return nil
+}
+
+// NormalizeExtensions rewrites the x-portway extensions of a project in
+// their typed form, with defaults filled in, so the project uploaded to
+// Portway carries every setting explicitly
+func NormalizeExtensions(project *types.Project) error {
+	ext, err := GetPortwayExtension(project)
+	if err != nil {
+		return err
+	}
+	if _, ok := project.Extensions[PortwayExtensionKey]; ok {
+		if err := encodeExtension(&project.Extensions, ext); err != nil {
+			return err
+		}
+	}
+
+	for name, service := range project.Services {
+		if _, ok := service.Extensions[PortwayExtensionKey]; !ok {
+			continue
+		}
+
+		ext, err := GetServiceExtension(service)
+		if err != nil {
+			return err
+		}
+		for i := range ext.Endpoints {
+			if ext.Endpoints[i].Path == "" {
+				ext.Endpoints[i].Path = "/"
+			}
+		}
+		if ext.Autoscaling != nil && ext.Autoscaling.MinReplicas == 0 {
+			ext.Autoscaling.MinReplicas = 1
+		}
+		if ext.Health != nil && ext.Health.Port == 0 && len(ext.Endpoints) > 0 {
+			ext.Health.Port = ext.Endpoints[0].Port
+		}
+
+		if err := encodeExtension(&service.Extensions, ext); err != nil {
+			return fmt.Errorf("service %s: %w", name, err)
+		}
+		project.Services[name] = service
+	}
+
+	return nil
+}
+
+// encodeExtension stores ext as plain maps and lists, like the loader does
+func encodeExtension(extensions *types.Extensions, ext any) error {
+	data, err := json.Marshal(ext)
+	if err != nil {
+		return fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+
+	if *extensions == nil {
+		*extensions = types.Extensions{}
+	}
+	(*extensions)[PortwayExtensionKey] = raw
+	return nil
+}
+
+// IgnoreExtensionKey is the service extension listing check codes that are
+// not reported for the service
+const IgnoreExtensionKey = "x-portway-ignore"
+
+// IgnoredChecks returns the check codes listed in x-portway-ignore, which
+// may be a list or a single comma-separated string
+func IgnoredChecks(service types.ServiceConfig) []string {
+	switch value := service.Extensions[IgnoreExtensionKey].(type) {
+	case string:
+		return strings.Split(value, ",")
+	case []any:
+		codes := make([]string, 0, len(value))
+		for _, code := range value {
+			codes = append(codes, fmt.Sprint(code))
+		}
+		return codes
+	default:
+		return nil
+	}
+}
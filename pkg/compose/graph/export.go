@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// Export formats for portway graph
+const (
+	FormatDOT     = "dot"
+	FormatMermaid = "mermaid"
+	FormatJSON    = "json"
+)
+
+// Formats lists the supported export formats
+var Formats = []string{FormatDOT, FormatMermaid, FormatJSON}
+
+// Write exports the graph. DOT and Mermaid only draw the networks when
+// networks is set, JSON always lists them.
+func Write(w io.Writer, format string, g *Graph, networks bool) error {
+	switch format {
+	case FormatDOT:
+		return writeDOT(w, g, networks)
+	case FormatMermaid:
+		return writeMermaid(w, g, networks)
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(g)
+	default:
+		return fmt.Errorf("unsupported format %q, expected one of %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+// edgeLabel describes an edge: the link or the condition when it is not
+// the default, and whether the services cannot reach each other
+func edgeLabel(edge Edge) string {
+	var parts []string
+	if edge.Kind == EdgeLink {
+		parts = append(parts, "link")
+	} else if edge.Condition != "" && edge.Condition != types.ServiceConditionStarted {
+		parts = append(parts, edge.Condition)
+	}
+	if !edge.Dangling && !edge.Reachable {
+		parts = append(parts, "no shared network")
+	}
+	return strings.Join(parts, ", ")
+}
+
+func nodeLabel(node Node, newline string) string {
+	if len(node.Ports) == 0 {
+		return node.Name
+	}
+	return node.Name + newline + strings.Join(node.Ports, ", ")
+}
+
+func writeDOT(w io.Writer, g *Graph, networks bool) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(g.Name))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n\n")
+
+	for _, node := range g.Nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", dotQuote(node.Name), dotQuote(nodeLabel(node, "\n")))
+	}
+	for _, edge := range g.Dangling() {
+		fmt.Fprintf(&b, "  %s [label=%s, style=dashed, color=red];\n", dotQuote(edge.To), dotQuote(edge.To+" (undefined)"))
+	}
+
+	if len(g.Edges) > 0 {
+		b.WriteString("\n")
+	}
+	for _, edge := range g.Edges {
+		attrs := []string{}
+		if label := edgeLabel(edge); label != "" {
+			attrs = append(attrs, "label="+dotQuote(label))
+		}
+		if edge.Kind == EdgeLink {
+			attrs = append(attrs, "style=dashed")
+		}
+		if edge.Dangling || !edge.Reachable {
+			attrs = append(attrs, "color=red")
+		}
+		fmt.Fprintf(&b, "  %s -> %s", dotQuote(edge.From), dotQuote(edge.To))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		b.WriteString(";\n")
+	}
+
+	if networks {
+		for _, network := range sortedKeys(g.Networks) {
+			id := dotQuote("network:" + network)
+			fmt.Fprintf(&b, "\n  %s [label=%s, shape=ellipse];\n", id, dotQuote(network))
+			for _, service := range g.Networks[network] {
+				fmt.Fprintf(&b, "  %s -> %s [dir=none, style=dotted];\n", dotQuote(service), id)
+			}
+		}
+	}
+
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func dotQuote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+	return `"` + value + `"`
+}
+
+// writeMermaid writes a flowchart. Nodes get generated ids since service
+// names may clash with Mermaid keywords such as end.
+func writeMermaid(w io.Writer, g *Graph, networks bool) error {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	ids := map[string]string{}
+	for i, node := range g.Nodes {
+		ids[node.Name] = fmt.Sprintf("s%d", i)
+		fmt.Fprintf(&b, "    %s[%s]\n", ids[node.Name], mermaidQuote(nodeLabel(node, "<br/>")))
+	}
+	for _, edge := range g.Dangling() {
+		if _, ok := ids[edge.To]; ok {
+			continue
+		}
+		ids[edge.To] = fmt.Sprintf("u%d", len(ids)-len(g.Nodes))
+		fmt.Fprintf(&b, "    %s[%s]:::dangling\n", ids[edge.To], mermaidQuote(edge.To+" (undefined)"))
+	}
+
+	for _, edge := range g.Edges {
+		arrow := "-->"
+		if edge.Kind == EdgeLink {
+			arrow = "-.->"
+		}
+		if label := edgeLabel(edge); label != "" {
+			arrow += "|" + mermaidQuote(label) + "|"
+		}
+		fmt.Fprintf(&b, "    %s %s %s\n", ids[edge.From], arrow, ids[edge.To])
+	}
+
+	if networks {
+		for i, network := range sortedKeys(g.Networks) {
+			id := fmt.Sprintf("n%d", i)
+			fmt.Fprintf(&b, "    %s((%s)):::network\n", id, mermaidQuote(network))
+			for _, service := range g.Networks[network] {
+				fmt.Fprintf(&b, "    %s -.- %s\n", ids[service], id)
+			}
+		}
+		b.WriteString("    classDef network fill:#eef,stroke:#99c\n")
+	}
+	if len(g.Dangling()) > 0 {
+		b.WriteString("    classDef dangling stroke:#d33,stroke-dasharray:4\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func mermaidQuote(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "#quot;") + `"`
+}
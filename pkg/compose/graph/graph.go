@@ -0,0 +1,287 @@
+// Package graph builds the dependency graph of the services of a compose
+// project from depends_on, links and the networks services share.
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// EdgeKind is the relation an edge stands for
+type EdgeKind string
+
+const (
+	EdgeDependsOn EdgeKind = "depends_on"
+	EdgeLink      EdgeKind = "link"
+)
+
+// Node is a service
+type Node struct {
+	Name  string `json:"name"`
+	Image string `json:"image,omitempty"`
+
+	// Ports lists the published ports and the exposed container ports
+	Ports []string `json:"ports,omitempty"`
+
+	// Networks lists the networks the service is attached to, empty for
+	// network_mode none and host
+	Networks []string `json:"networks"`
+}
+
+// Edge points from a service to a service it needs before it starts
+type Edge struct {
+	From      string   `json:"from"`
+	To        string   `json:"to"`
+	Kind      EdgeKind `json:"kind"`
+	Condition string   `json:"condition,omitempty"`
+
+	// Dangling is set when To is not a service of the project
+	Dangling bool `json:"dangling,omitempty"`
+
+	// Reachable is set when From and To share a network
+	Reachable bool `json:"reachable"`
+}
+
+// Graph is the dependency graph of a project. Nodes and edges are sorted by
+// name, networks map to the services attached to them.
+type Graph struct {
+	Name     string              `json:"project"`
+	Nodes    []Node              `json:"services"`
+	Edges    []Edge              `json:"dependencies"`
+	Networks map[string][]string `json:"networks"`
+}
+
+// New builds the graph of a project. Optional dependencies on services
+// disabled by a profile are left out.
+func New(project *types.Project) *Graph {
+	g := &Graph{Name: project.Name, Networks: map[string][]string{}}
+
+	names := project.ServiceNames()
+	sort.Strings(names)
+
+	networks := make(map[string][]string, len(names))
+	for _, name := range names {
+		networks[name] = serviceNetworks(project, name)
+	}
+
+	for _, name := range names {
+		service := project.Services[name]
+		g.Nodes = append(g.Nodes, Node{
+			Name:     name,
+			Image:    service.Image,
+			Ports:    ports(service),
+			Networks: networks[name],
+		})
+		for _, network := range networks[name] {
+			g.Networks[network] = append(g.Networks[network], name)
+		}
+
+		links := map[string]bool{}
+		for _, link := range service.Links {
+			target, _, _ := strings.Cut(link, ":")
+			links[target] = true
+		}
+
+		for _, target := range sortedKeys(service.DependsOn) {
+			dependency := service.DependsOn[target]
+			_, defined := project.Services[target]
+			if _, disabled := project.DisabledServices[target]; disabled && !dependency.Required {
+				continue
+			}
+
+			edge := Edge{From: name, To: target, Kind: EdgeDependsOn, Condition: dependency.Condition, Dangling: !defined}
+			if links[target] {
+				// Links are loaded as depends_on with the default condition
+				edge.Kind = EdgeLink
+			}
+			if defined {
+				edge.Reachable = shareNetwork(networks[name], networks[target])
+			}
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+
+	return g
+}
+
+// serviceNetworks returns the networks of a service, following
+// network_mode service:<name>
+func serviceNetworks(project *types.Project, name string) []string {
+	seen := map[string]bool{}
+	for {
+		service, ok := project.Services[name]
+		if !ok || seen[name] {
+			return nil
+		}
+		seen[name] = true
+
+		target, found := strings.CutPrefix(service.NetworkMode, types.ServicePrefix)
+		if !found {
+			if service.NetworkMode != "" && len(service.Networks) == 0 {
+				return nil
+			}
+			return sortedKeys(service.Networks)
+		}
+		name = target
+	}
+}
+
+func shareNetwork(a, b []string) bool {
+	for _, network := range a {
+		if slices.Contains(b, network) {
+			return true
+		}
+	}
+	return false
+}
+
+func ports(service types.ServiceConfig) []string {
+	var ports []string
+	for _, port := range service.Ports {
+		value := fmt.Sprint(port.Target)
+		if port.Published != "" {
+			value = port.Published + ":" + value
+		}
+		if port.Protocol != "" && port.Protocol != "tcp" {
+			value += "/" + port.Protocol
+		}
+		ports = append(ports, value)
+	}
+	return append(ports, service.Expose...)
+}
+
+// Node returns the node of a service
+func (g *Graph) Node(name string) (Node, bool) {
+	for _, node := range g.Nodes {
+		if node.Name == name {
+			return node, true
+		}
+	}
+	return Node{}, false
+}
+
+// Dependents returns the services that depend on or link to a service
+func (g *Graph) Dependents(name string) []string {
+	var dependents []string
+	for _, edge := range g.Edges {
+		if edge.To == name && !slices.Contains(dependents, edge.From) {
+			dependents = append(dependents, edge.From)
+		}
+	}
+	return dependents
+}
+
+// Dangling returns the edges to services that are not defined
+func (g *Graph) Dangling() []Edge {
+	var dangling []Edge
+	for _, edge := range g.Edges {
+		if edge.Dangling {
+			dangling = append(dangling, edge)
+		}
+	}
+	return dangling
+}
+
+// Unreachable returns the edges between services that share no network
+func (g *Graph) Unreachable() []Edge {
+	var unreachable []Edge
+	for _, edge := range g.Edges {
+		if !edge.Dangling && !edge.Reachable {
+			unreachable = append(unreachable, edge)
+		}
+	}
+	return unreachable
+}
+
+// Orphans returns the services nothing depends on that have no ports
+func (g *Graph) Orphans() []string {
+	var orphans []string
+	for _, node := range g.Nodes {
+		if len(node.Ports) == 0 && len(g.Dependents(node.Name)) == 0 {
+			orphans = append(orphans, node.Name)
+		}
+	}
+	return orphans
+}
+
+// Cycles returns the cycles in the startup order, each as the edges from
+// a service back to itself
+func (g *Graph) Cycles() [][]Edge {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	outgoing := map[string][]Edge{}
+	for _, edge := range g.Edges {
+		if !edge.Dangling {
+			outgoing[edge.From] = append(outgoing[edge.From], edge)
+		}
+	}
+
+	state := map[string]int{}
+	var cycles [][]Edge
+	var path []Edge
+
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		for _, edge := range outgoing[name] {
+			switch state[edge.To] {
+			case unvisited:
+				path = append(path, edge)
+				visit(edge.To)
+				path = path[:len(path)-1]
+			case visiting:
+				start := len(path)
+				for i, step := range path {
+					if step.From == edge.To {
+						start = i
+						break
+					}
+				}
+				cycles = append(cycles, append(slices.Clone(path[start:]), edge))
+			}
+		}
+		state[name] = visited
+	}
+
+	for _, node := range g.Nodes {
+		if state[node.Name] == unvisited {
+			visit(node.Name)
+		}
+	}
+	return cycles
+}
+
+// FormatCycle formats a cycle as a -> b -> a
+func FormatCycle(cycle []Edge) string {
+	names := make([]string, 0, len(cycle)+1)
+	for _, edge := range cycle {
+		names = append(names, edge.From)
+	}
+	if len(cycle) > 0 {
+		names = append(names, cycle[len(cycle)-1].To)
+	}
+	return strings.Join(names, " -> ")
+}
+
+// Broken reports whether the graph has cycles or dangling edges, which
+// the compose loader rejects
+func (g *Graph) Broken() bool {
+	return len(g.Dangling()) > 0 || len(g.Cycles()) > 0
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
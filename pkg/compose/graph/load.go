@@ -0,0 +1,25 @@
+package graph
+
+import (
+	"cli/pkg/compose"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// Load loads a project like compose.LoadComposeConfigWithOptions. The loader
+// rejects dependency cycles and undefined dependencies; such projects are
+// loaded again without its consistency check so the graph can point them
+// out. Other load errors are returned as is.
+func Load(configs []string, opts compose.LoadOptions) (*types.Project, error) {
+	project, _, err := compose.LoadComposeConfigWithOptions(configs, opts)
+	if err == nil {
+		return project, nil
+	}
+
+	unchecked, _, uncheckedErr := compose.LoadComposeConfigWithOptions(configs, opts, cli.WithConsistency(false))
+	if uncheckedErr != nil || !New(unchecked).Broken() {
+		return nil, err
+	}
+	return unchecked, nil
+}
@@ -0,0 +1,201 @@
+package compose
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"github.com/compose-spec/compose-go/v2/dotenv"
+	interp "github.com/compose-spec/compose-go/v2/interpolation"
+	"github.com/compose-spec/compose-go/v2/loader"
+	"github.com/compose-spec/compose-go/v2/template"
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// LoadOptions controls which values may be used for ${VAR} interpolation.
+// Nothing is read from the shell environment or a local .env file unless it
+// is explicitly allowed here.
+type LoadOptions struct {
+	// EnvFiles are read in order, later files override earlier ones
+	EnvFiles []string
+	// Variables are explicit values, e.g. from the environment in .portway.yaml
+	Variables map[string]string
+	// AllowedEnv lists the shell environment variables that may be
+	// interpolated. A trailing * matches any suffix, e.g. CI_*.
+	AllowedEnv []string
+}
+
+// VariableSource identifies where an interpolated value came from
+type VariableSource string
+
+const (
+	SourceEnvFile VariableSource = "env-file"
+	SourceConfig  VariableSource = "config"
+	SourceShell   VariableSource = "shell"
+)
+
+// InterpolatedVariable is a variable that was substituted while loading
+type InterpolatedVariable struct {
+	Name   string
+	Source VariableSource
+	// File is the env file the value was read from, for SourceEnvFile
+	File string
+}
+
+// InterpolationReport lists the variables used while loading a project
+type InterpolationReport struct {
+	Variables []InterpolatedVariable
+	Missing   []string
+}
+
+// UnresolvedVariablesError is returned when a compose file references
+// variables that none of the allowed sources define.
+type UnresolvedVariablesError struct {
+	Names []string
+}
+
+func (e *UnresolvedVariablesError) Error() string {
+	return fmt.Sprintf("unresolved variables in compose file: %s", strings.Join(e.Names, ", "))
+}
+
+// LoadComposeConfigWithOptions loads the compose files, interpolating only
+// from the sources allowed by opts, and reports where every value came from.
+// options are applied after the defaults.
+func LoadComposeConfigWithOptions(configs []string, opts LoadOptions, options ...cli.ProjectOptionsFn) (*types.Project, *InterpolationReport, error) {
+	values := map[string]string{}
+	sources := map[string]InterpolatedVariable{}
+
+	noLookup := func(string) (string, bool) { return "", false }
+	for _, file := range opts.EnvFiles {
+		fileValues, err := dotenv.ReadFile(file, noLookup)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read env file %s: %w", file, err)
+		}
+		for key, value := range fileValues {
+			values[key] = value
+			sources[key] = InterpolatedVariable{Name: key, Source: SourceEnvFile, File: file}
+		}
+	}
+
+	for key, value := range opts.Variables {
+		values[key] = value
+		sources[key] = InterpolatedVariable{Name: key, Source: SourceConfig}
+	}
+
+	for _, entry := range os.Environ() {
+		key, value, _ := strings.Cut(entry, "=")
+		if isAllowedEnv(key, opts.AllowedEnv) {
+			values[key] = value
+			sources[key] = InterpolatedVariable{Name: key, Source: SourceShell}
+		}
+	}
+
+	var mu sync.Mutex
+	used := map[string]bool{}
+	missing := map[string]bool{}
+
+	lookup := func(key string) (string, bool) {
+		value, ok := values[key]
+		if ok {
+			mu.Lock()
+			used[key] = true
+			mu.Unlock()
+		}
+		return value, ok
+	}
+
+	replace := func(substring string, mapping template.Mapping, cfg *template.Config) (string, error) {
+		value, applied, err := template.DefaultReplacementAppliedFunc(substring, mapping, cfg)
+		if err == nil && !applied {
+			if name := variableName(substring); name != "" {
+				mu.Lock()
+				missing[name] = true
+				mu.Unlock()
+			}
+		}
+		return value, err
+	}
+
+	projectOpts, err := cli.NewProjectOptions(
+		configs,
+		append([]cli.ProjectOptionsFn{
+			cli.WithEnv(toEnvList(values)),
+			cli.WithLoadOptions(func(o *loader.Options) {
+				if o.Interpolate == nil {
+					o.Interpolate = &interp.Options{}
+				}
+				o.Interpolate.LookupValue = lookup
+				o.Interpolate.Substitute = func(value string, mapping template.Mapping) (string, error) {
+					return template.SubstituteWithOptions(value, mapping, template.WithoutLogging, template.WithReplacementFunction(replace))
+				}
+			}),
+		}, options...)...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	project, err := projectOpts.LoadProject(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := &InterpolationReport{}
+	for key := range used {
+		report.Variables = append(report.Variables, sources[key])
+	}
+	sort.Slice(report.Variables, func(i, j int) bool {
+		return report.Variables[i].Name < report.Variables[j].Name
+	})
+
+	for key := range missing {
+		report.Missing = append(report.Missing, key)
+	}
+	sort.Strings(report.Missing)
+
+	if len(report.Missing) > 0 {
+		return nil, report, &UnresolvedVariablesError{Names: report.Missing}
+	}
+
+	return project, report, nil
+}
+
+func isAllowedEnv(key string, allowed []string) bool {
+	for _, pattern := range allowed {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+			continue
+		}
+		if key == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// variableName extracts the variable name from a $VAR or ${VAR...} substring
+func variableName(substring string) string {
+	name := strings.TrimPrefix(substring, "$")
+	name = strings.TrimPrefix(name, "{")
+	end := strings.IndexFunc(name, func(r rune) bool {
+		return !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	if end >= 0 {
+		name = name[:end]
+	}
+	return name
+}
+
+func toEnvList(values map[string]string) []string {
+	env := make([]string, 0, len(values))
+	for key, value := range values {
+		env = append(env, key+"="+value)
+	}
+	return env
+}
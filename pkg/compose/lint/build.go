@@ -0,0 +1,366 @@
+package lint
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/moby/patternmatcher"
+	"github.com/moby/patternmatcher/ignorefile"
+)
+
+// maxLargestPaths is the number of paths listed for a large build context
+const maxLargestPaths = 10
+
+// buildContext is what a service build sends to the builder
+type buildContext struct {
+	// Dir is the context directory, empty for remote contexts
+	Dir string
+	// Missing is set when Dir does not exist
+	Missing bool
+
+	// Dockerfile is the path of the Dockerfile, empty when it is inline
+	Dockerfile string
+	// Instructions are nil when the Dockerfile cannot be read
+	Instructions  []instruction
+	DockerfileErr error
+
+	// Ignore is the path of the ignore file in use, empty when there is none
+	Ignore string
+
+	// Size is the total size of the files sent, Largest the top-level paths
+	// by size and Unwanted the paths that rarely belong in an image
+	Size     int64
+	Largest  []contextPath
+	Unwanted []string
+	ScanErr  error
+}
+
+type contextPath struct {
+	Path string
+	Size int64
+}
+
+// build returns the build context of a service, scanning it the first time.
+// It returns nil for services without a local build.
+func (c *Context) build(service types.ServiceConfig) *buildContext {
+	if service.Build == nil {
+		return nil
+	}
+
+	key := service.Build.Context + "\x00" + service.Build.Dockerfile + "\x00" + service.Build.DockerfileInline
+	if build, ok := c.builds[key]; ok {
+		return build
+	}
+	if c.builds == nil {
+		c.builds = map[string]*buildContext{}
+	}
+
+	build := scanBuild(service.Build)
+	c.builds[key] = build
+	return build
+}
+
+// isRemoteContext reports whether a build context is a URL or git repository
+func isRemoteContext(context string) bool {
+	return strings.Contains(context, "://") || strings.HasPrefix(context, "git@") || strings.HasPrefix(context, "github.com/")
+}
+
+func scanBuild(config *types.BuildConfig) *buildContext {
+	if isRemoteContext(config.Context) {
+		return nil
+	}
+
+	build := &buildContext{Dir: config.Context}
+	if info, err := os.Stat(build.Dir); err != nil || !info.IsDir() {
+		build.Missing = true
+		return build
+	}
+
+	if config.DockerfileInline != "" {
+		build.Instructions, build.DockerfileErr = parseDockerfile(strings.NewReader(config.DockerfileInline))
+	} else {
+		build.Dockerfile = config.Dockerfile
+		if !filepath.IsAbs(build.Dockerfile) {
+			build.Dockerfile = filepath.Join(build.Dir, build.Dockerfile)
+		}
+		build.Instructions, build.DockerfileErr = readDockerfile(build.Dockerfile)
+	}
+
+	// A <Dockerfile>.dockerignore next to the Dockerfile takes precedence
+	candidates := []string{filepath.Join(build.Dir, ".dockerignore")}
+	if build.Dockerfile != "" {
+		candidates = slices.Insert(candidates, 0, build.Dockerfile+".dockerignore")
+	}
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			build.Ignore = candidate
+			break
+		}
+	}
+
+	build.ScanErr = build.scan()
+	return build
+}
+
+// scan walks the context like the builder does, skipping ignored paths
+func (b *buildContext) scan() error {
+	var patterns []string
+	if b.Ignore != "" {
+		file, err := os.Open(b.Ignore)
+		if err != nil {
+			return err
+		}
+		patterns, err = ignorefile.ReadAll(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", b.Ignore, err)
+		}
+	}
+
+	matcher, err := patternmatcher.New(patterns)
+	if err != nil {
+		return fmt.Errorf("invalid pattern in %s: %w", b.Ignore, err)
+	}
+
+	sizes := map[string]int64{}
+	err = filepath.WalkDir(b.Dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrPermission) {
+				return nil
+			}
+			return err
+		}
+		if path == b.Dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(b.Dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		ignored, err := matcher.MatchesOrParentMatches(rel)
+		if err != nil {
+			return err
+		}
+		if ignored {
+			if entry.IsDir() && !matcher.Exclusions() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if isUnwanted(entry) && !slices.ContainsFunc(b.Unwanted, func(parent string) bool {
+			return strings.HasPrefix(rel, parent+"/")
+		}) {
+			b.Unwanted = append(b.Unwanted, rel)
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+
+		top, _, _ := strings.Cut(rel, "/")
+		sizes[top] += info.Size()
+		b.Size += info.Size()
+		return nil
+	})
+
+	for path, size := range sizes {
+		b.Largest = append(b.Largest, contextPath{Path: path, Size: size})
+	}
+	sort.Slice(b.Largest, func(i, j int) bool {
+		if b.Largest[i].Size != b.Largest[j].Size {
+			return b.Largest[i].Size > b.Largest[j].Size
+		}
+		return b.Largest[i].Path < b.Largest[j].Path
+	})
+	if len(b.Largest) > maxLargestPaths {
+		b.Largest = b.Largest[:maxLargestPaths]
+	}
+	return err
+}
+
+// isUnwanted reports whether a path rarely belongs in a build context:
+// repositories, installed dependencies and env files with secrets
+func isUnwanted(entry fs.DirEntry) bool {
+	name := entry.Name()
+	if entry.IsDir() {
+		return name == ".git" || name == "node_modules"
+	}
+	if name == ".env" {
+		return true
+	}
+	suffix, ok := strings.CutPrefix(name, ".env.")
+	return ok && !slices.Contains([]string{"example", "sample", "template", "dist"}, suffix)
+}
+
+// instruction is a Dockerfile instruction with its flags and arguments
+type instruction struct {
+	Line    int
+	Command string
+	Flags   []string
+	Args    []string
+}
+
+// flag returns the value of a --name=value flag of the instruction
+func (i instruction) flag(name string) (string, bool) {
+	for _, flag := range i.Flags {
+		key, value, _ := strings.Cut(strings.TrimPrefix(flag, "--"), "=")
+		if key == name {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func readDockerfile(path string) ([]instruction, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return parseDockerfile(file)
+}
+
+// parseDockerfile splits a Dockerfile into instructions. It handles
+// comments, line continuations, the escape directive and heredocs, which
+// is all the checks need.
+func parseDockerfile(r io.Reader) ([]instruction, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	escape := `\`
+	directives := true
+	var instructions []instruction
+	var current strings.Builder
+	start := 0
+	heredoc := ""
+
+	for number := 1; scanner.Scan(); number++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if heredoc != "" {
+			if strings.TrimLeft(line, "\t") == heredoc {
+				heredoc = ""
+			}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "#") {
+			if directives {
+				if value, ok := strings.CutPrefix(strings.ReplaceAll(trimmed, " ", ""), "#escape="); ok && value != "" {
+					escape = value
+				}
+			}
+			continue
+		}
+		directives = false
+		if trimmed == "" {
+			continue
+		}
+
+		if current.Len() == 0 {
+			start = number
+		}
+		if continued, ok := strings.CutSuffix(trimmed, escape); ok {
+			current.WriteString(continued + " ")
+			continue
+		}
+		current.WriteString(trimmed)
+
+		fields := strings.Fields(current.String())
+		current.Reset()
+		if len(fields) == 0 {
+			continue
+		}
+
+		inst := instruction{Line: start, Command: strings.ToUpper(fields[0])}
+		for _, field := range fields[1:] {
+			if len(inst.Args) == 0 && strings.HasPrefix(field, "--") {
+				inst.Flags = append(inst.Flags, field)
+				continue
+			}
+			inst.Args = append(inst.Args, field)
+			if delimiter, ok := strings.CutPrefix(field, "<<"); ok && heredoc == "" {
+				heredoc = strings.Trim(strings.TrimPrefix(delimiter, "-"), `"'`)
+			}
+		}
+		instructions = append(instructions, inst)
+	}
+
+	return instructions, scanner.Err()
+}
+
+// stage is a FROM instruction with its base image and stage name
+type stage struct {
+	instruction
+	Image string
+	Name  string
+}
+
+// stages returns the build stages. Build args are expanded in the base
+// image from the global ARG defaults and the build args of the service.
+func stages(instructions []instruction, buildArgs types.MappingWithEquals) []stage {
+	args := map[string]string{}
+	var result []stage
+	for _, inst := range instructions {
+		switch inst.Command {
+		case "ARG":
+			if len(result) > 0 {
+				continue
+			}
+			for _, arg := range inst.Args {
+				name, value, _ := strings.Cut(arg, "=")
+				args[name] = strings.Trim(value, `"'`)
+			}
+		case "FROM":
+			if len(inst.Args) == 0 {
+				continue
+			}
+			s := stage{instruction: inst, Image: inst.Args[0]}
+			if len(inst.Args) >= 3 && strings.EqualFold(inst.Args[1], "AS") {
+				s.Name = inst.Args[2]
+			}
+			s.Image = os.Expand(s.Image, func(name string) string {
+				if value, ok := buildArgs[name]; ok && value != nil {
+					return *value
+				}
+				return args[name]
+			})
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// hasStage reports whether a stage is named target
+func hasStage(stages []stage, target string) bool {
+	return slices.ContainsFunc(stages, func(s stage) bool {
+		return strings.EqualFold(s.Name, target)
+	})
+}
+
+// formatLargest formats the largest paths of a context as a list
+func formatLargest(paths []contextPath) string {
+	parts := make([]string, 0, len(paths))
+	for _, path := range paths {
+		parts = append(parts, fmt.Sprintf("%s (%s)", path.Path, formatBytes(path.Size)))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,87 @@
+package lint
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/compose"
+	"sort"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// Context is what a check inspects. Sources holds the compose files as
+// written, Plan the limits of the organization and Images the registry
+// lookups of the service images; they are nil when not available.
+type Context struct {
+	Project *types.Project
+	Policy  *Policy
+	Sources *compose.SourceMap
+	Plan    *api.PlanLimits
+	Images  map[string]ImageLookup
+
+	// builds caches the scanned build contexts and normalized the project
+	// as JSON for custom rules
+	builds     map[string]*buildContext
+	normalized map[string]any
+}
+
+// Check is a local validation rule. Run returns the issues it finds; their
+// code, severity, category and doc URL are filled in from the check. Fix,
+// when set, edits the compose file to resolve one issue and reports whether
+// it changed anything.
+type Check struct {
+	Code        string
+	Name        string
+	Description string
+	Severity    Severity
+	Category    string
+	DocURL      string
+	Run         func(ctx *Context) []Issue
+	Fix         func(ctx *FixContext) bool
+}
+
+// Registry holds the local checks by code
+type Registry struct {
+	checks map[string]Check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{checks: make(map[string]Check)}
+}
+
+// DefaultRegistry holds the built-in checks
+var DefaultRegistry = NewRegistry()
+
+// Register adds a check to the default registry
+func Register(check Check) {
+	DefaultRegistry.Register(check)
+}
+
+// Clone returns a registry with the same checks, to add checks to
+func (r *Registry) Clone() *Registry {
+	clone := NewRegistry()
+	for code, check := range r.checks {
+		clone.checks[code] = check
+	}
+	return clone
+}
+
+func (r *Registry) Register(check Check) {
+	r.checks[check.Code] = check
+}
+
+func (r *Registry) Get(code string) (Check, bool) {
+	check, ok := r.checks[code]
+	return check, ok
+}
+
+// All returns the registered checks sorted by code
+func (r *Registry) All() []Check {
+	checks := make([]Check, 0, len(r.checks))
+	for _, check := range r.checks {
+		checks = append(checks, check)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].Code < checks[j].Code
+	})
+	return checks
+}
@@ -0,0 +1,278 @@
+package lint
+
+import (
+	"cli/pkg/compose"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/distribution/reference"
+)
+
+// builtService is a service with a local build context
+type builtService struct {
+	types.ServiceConfig
+	Scan *buildContext
+}
+
+// builtServices returns the services with a local build context. Remote
+// contexts are not checked.
+func builtServices(ctx *Context) []builtService {
+	var services []builtService
+	for _, name := range ctx.Project.ServicesWithBuild() {
+		service := ctx.Project.Services[name]
+		if build := ctx.build(service); build != nil {
+			services = append(services, builtService{ServiceConfig: service, Scan: build})
+		}
+	}
+	return services
+}
+
+// dockerfileIssue returns an issue located at an instruction of the
+// Dockerfile of a service
+func dockerfileIssue(service builtService, inst instruction) Issue {
+	issue := Issue{Service: service.Name, Field: "services." + service.Name + ".build.dockerfile_inline"}
+	if service.Scan.Dockerfile != "" {
+		issue.Field = "services." + service.Name + ".build.dockerfile"
+		issue.Position = compose.Position{File: service.Scan.Dockerfile, Line: inst.Line, Column: 1}
+	}
+	return issue
+}
+
+var checkDockerfile = Check{
+	Code:        "PW070",
+	Name:        "Missing Dockerfile",
+	Description: "Check that build contexts and Dockerfiles exist",
+	Severity:    SeverityError,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			issue := Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".build.dockerfile",
+				Context:    "docker compose build fails before the deploy starts.",
+				Suggestion: "Fix the path in build, relative to the compose file for context and to the context for dockerfile.",
+			}
+			switch build := service.Scan; {
+			case build.Missing:
+				issue.Field = "services." + service.Name + ".build.context"
+				issue.Message = fmt.Sprintf("Build context %s does not exist", projectPath(ctx, build.Dir))
+			case errors.Is(build.DockerfileErr, fs.ErrNotExist):
+				issue.Message = fmt.Sprintf("Dockerfile %s does not exist", projectPath(ctx, build.Dockerfile))
+			case build.DockerfileErr != nil:
+				issue.Message = fmt.Sprintf("Dockerfile cannot be read: %v", build.DockerfileErr)
+			default:
+				continue
+			}
+			issues = append(issues, issue)
+		}
+		return issues
+	},
+}
+
+var checkBuildTarget = Check{
+	Code:        "PW071",
+	Name:        "Missing Build Target",
+	Description: "Check that build targets are stages of the Dockerfile",
+	Severity:    SeverityError,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			target := service.Build.Target
+			if target == "" || service.Scan.Instructions == nil {
+				continue
+			}
+
+			stages := stages(service.Scan.Instructions, service.Build.Args)
+			if hasStage(stages, target) {
+				continue
+			}
+
+			var names []string
+			for _, stage := range stages {
+				if stage.Name != "" {
+					names = append(names, stage.Name)
+				}
+			}
+			suggestion := fmt.Sprintf("Name a stage with FROM <image> AS %s.", target)
+			if len(names) > 0 {
+				suggestion = "Use one of the stages: " + strings.Join(names, ", ") + "."
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".build.target",
+				Message:    fmt.Sprintf("Build target %q is not a stage of the Dockerfile", target),
+				Context:    "docker compose build fails before the deploy starts.",
+				Suggestion: suggestion,
+			})
+		}
+		return issues
+	},
+}
+
+var checkDockerignore = Check{
+	Code:        "PW072",
+	Name:        "Missing .dockerignore",
+	Description: "Check for build contexts without a .dockerignore",
+	Severity:    SeverityWarning,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			if service.Scan.Missing || service.Scan.Ignore != "" {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".build.context",
+				Message:    fmt.Sprintf("Build context %s has no .dockerignore", projectPath(ctx, service.Scan.Dir)),
+				Context:    "Everything in the context is sent to the builder, including repositories, dependencies and env files, which slows builds and can leak secrets into the image.",
+				Suggestion: "Add a .dockerignore to the context listing .git, node_modules, .env and build output.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkContextSize = Check{
+	Code:        "PW073",
+	Name:        "Large Build Context",
+	Description: "Check for build contexts larger than the lint max-context-size",
+	Severity:    SeverityWarning,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		limit, err := ctx.Policy.ContextSizeLimit()
+		if err != nil {
+			return nil
+		}
+
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			if service.Scan.Size <= limit {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".build.context",
+				Message:    fmt.Sprintf("Build context %s is %s, over the limit of %s", projectPath(ctx, service.Scan.Dir), formatBytes(service.Scan.Size), formatBytes(limit)),
+				Context:    "The context is uploaded to the builder on every build. Largest paths: " + formatLargest(service.Scan.Largest) + ".",
+				Suggestion: "Exclude what the image does not need in .dockerignore, or raise max-context-size in the lint section of .portway.yaml.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkUnwantedContextFiles = Check{
+	Code:        "PW074",
+	Name:        "Unwanted Files in Build Context",
+	Description: "Check for .git, node_modules and .env files sent to the builder",
+	Severity:    SeverityWarning,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			if len(service.Scan.Unwanted) == 0 {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".build.context",
+				Message:    "Build context sends " + joinLimited(service.Scan.Unwanted, 5) + " to the builder",
+				Context:    "Repositories and installed dependencies make the context large, and env files hold secrets that a COPY . can put in the image layers.",
+				Suggestion: "Add them to .dockerignore and install dependencies in the Dockerfile.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkAddURL = Check{
+	Code:        "PW075",
+	Name:        "ADD From URL",
+	Description: "Check for Dockerfile ADD instructions that download URLs without a checksum",
+	Severity:    SeverityWarning,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			for _, inst := range service.Scan.Instructions {
+				if inst.Command != "ADD" || len(inst.Args) < 2 {
+					continue
+				}
+				if _, ok := inst.flag("checksum"); ok {
+					continue
+				}
+				for _, source := range inst.Args[:len(inst.Args)-1] {
+					if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+						continue
+					}
+					issue := dockerfileIssue(service, inst)
+					issue.Message = "ADD downloads " + source + " without a checksum"
+					issue.Context = "The download is not verified and can change between builds without invalidating the build cache."
+					issue.Suggestion = "Pin it with ADD --checksum=sha256:<digest>, or download and verify it in a RUN step."
+					issues = append(issues, issue)
+				}
+			}
+		}
+		return issues
+	},
+}
+
+var checkLatestBaseImage = Check{
+	Code:        "PW076",
+	Name:        "Latest Base Image",
+	Description: "Check for Dockerfile FROM images without a tag or with the latest tag",
+	Severity:    SeverityWarning,
+	Category:    "build",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range builtServices(ctx) {
+			stages := stages(service.Scan.Instructions, service.Build.Args)
+			for i, stage := range stages {
+				// Earlier stages, scratch and unresolved build args are not images
+				image := stage.Image
+				if strings.EqualFold(image, "scratch") || strings.Contains(image, "$") || hasStage(stages[:i], image) {
+					continue
+				}
+
+				named, err := reference.ParseNormalizedNamed(image)
+				if err != nil {
+					continue
+				}
+				if _, digested := named.(reference.Digested); digested {
+					continue
+				}
+
+				message := fmt.Sprintf("Base image %s has no tag", image)
+				if tagged, ok := named.(reference.Tagged); ok {
+					if tagged.Tag() != "latest" {
+						continue
+					}
+					message = fmt.Sprintf("Base image %s uses the latest tag", image)
+				}
+
+				issue := dockerfileIssue(service, stage.instruction)
+				issue.Message = message
+				issue.Context = "Builds pull whatever version is current, so the same commit can produce different images."
+				issue.Suggestion = "Pin a version tag, e.g. " + reference.FamiliarName(named) + ":1.2.3, or a digest."
+				issues = append(issues, issue)
+			}
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkDockerfile)
+	Register(checkBuildTarget)
+	Register(checkDockerignore)
+	Register(checkContextSize)
+	Register(checkUnwantedContextFiles)
+	Register(checkAddURL)
+	Register(checkLatestBaseImage)
+}
@@ -0,0 +1,129 @@
+package lint
+
+import (
+	"cli/pkg/compose/graph"
+	"fmt"
+)
+
+// dependencyField returns the field an edge of the graph is declared in
+func dependencyField(edge graph.Edge) string {
+	if edge.Kind == graph.EdgeLink {
+		return "services." + edge.From + ".links"
+	}
+	return "services." + edge.From + ".depends_on." + edge.To
+}
+
+// dependencyVerb describes an edge of the graph in a message
+func dependencyVerb(edge graph.Edge) string {
+	if edge.Kind == graph.EdgeLink {
+		return "Links to"
+	}
+	return "Depends on"
+}
+
+var checkDependencyCycle = Check{
+	Code:        "PW060",
+	Name:        "Dependency Cycle",
+	Description: "Check for services that wait on each other through depends_on and links",
+	Severity:    SeverityError,
+	Category:    "dependencies",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, cycle := range graph.New(ctx.Project).Cycles() {
+			issues = append(issues, Issue{
+				Service:    cycle[0].From,
+				Field:      dependencyField(cycle[0]),
+				Message:    "Services wait on each other: " + graph.FormatCycle(cycle),
+				Context:    "Each service starts after the services it depends on or links to, so none of the services in the cycle can start.",
+				Suggestion: "Remove one of the dependencies; services can still reach each other by name without depends_on or links.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkUndefinedDependency = Check{
+	Code:        "PW061",
+	Name:        "Undefined Dependency",
+	Description: "Check for depends_on and links that refer to services that are not defined",
+	Severity:    SeverityError,
+	Category:    "dependencies",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, edge := range graph.New(ctx.Project).Dangling() {
+			verb := dependencyVerb(edge)
+			message := fmt.Sprintf("%s undefined service %q", verb, edge.To)
+			suggestion := "Define the service, fix the name or remove the dependency."
+			if _, disabled := ctx.Project.DisabledServices[edge.To]; disabled {
+				message = fmt.Sprintf("%s service %q, which is disabled by its profiles", verb, edge.To)
+				suggestion = "Enable a profile of the service, or set required: false on the dependency."
+			}
+			issues = append(issues, Issue{
+				Service:    edge.From,
+				Field:      dependencyField(edge),
+				Message:    message,
+				Context:    "The service waits for its dependencies before it starts, so it never starts.",
+				Suggestion: suggestion,
+			})
+		}
+		return issues
+	},
+}
+
+var checkUnreachableDependency = Check{
+	Code:        "PW062",
+	Name:        "Unreachable Dependency",
+	Description: "Check for dependencies on services that share no network",
+	Severity:    SeverityError,
+	Category:    "dependencies",
+	Run: func(ctx *Context) []Issue {
+		g := graph.New(ctx.Project)
+
+		var issues []Issue
+		for _, edge := range g.Unreachable() {
+			from, _ := g.Node(edge.From)
+			to, _ := g.Node(edge.To)
+
+			suggestion := fmt.Sprintf("Attach %s and %s to a common network.", edge.From, edge.To)
+			if len(to.Networks) > 0 && len(from.Networks) > 0 {
+				suggestion = fmt.Sprintf("Add %s to the networks of %s.", to.Networks[0], edge.From)
+			}
+			issues = append(issues, Issue{
+				Service:    edge.From,
+				Field:      dependencyField(edge),
+				Message:    fmt.Sprintf("%s %s, but they share no network", dependencyVerb(edge), edge.To),
+				Context:    "Services only reach each other on the networks they are both attached to; network_mode none and host attach to none.",
+				Suggestion: suggestion,
+			})
+		}
+		return issues
+	},
+}
+
+var checkOrphanService = Check{
+	Code:        "PW063",
+	Name:        "Orphan Service",
+	Description: "Check for services nothing depends on that have no ports",
+	Severity:    SeverityWarning,
+	Category:    "dependencies",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, name := range graph.New(ctx.Project).Orphans() {
+			issues = append(issues, Issue{
+				Service:    name,
+				Field:      "services." + name,
+				Message:    "No service depends on this service and it has no ports",
+				Context:    "It serves no traffic and nothing waits for it. Background workers look like this; ignore the check for them with x-portway-ignore: [PW063].",
+				Suggestion: "Remove the service if it is unused, or publish or expose the port it listens on.",
+			})
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkDependencyCycle)
+	Register(checkUndefinedDependency)
+	Register(checkUnreachableDependency)
+	Register(checkOrphanService)
+}
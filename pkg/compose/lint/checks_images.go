@@ -0,0 +1,233 @@
+package lint
+
+import (
+	"cli/pkg/registry"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/distribution/reference"
+)
+
+// dockerHubDomain is the registry of images named without a host
+const dockerHubDomain = "docker.io"
+
+// pulledImage returns the parsed image of a service that is pulled rather
+// than built, and false for built or invalid images
+func pulledImage(service types.ServiceConfig) (reference.Named, bool) {
+	if service.Build != nil || service.Image == "" {
+		return nil, false
+	}
+	named, err := reference.ParseNormalizedNamed(service.Image)
+	return named, err == nil
+}
+
+// lookup returns the registry lookup of a pulled image, and false when the
+// registry was not asked or did not answer
+func lookup(ctx *Context, service types.ServiceConfig) (ImageLookup, bool) {
+	if _, ok := pulledImage(service); !ok || ctx.Images == nil {
+		return ImageLookup{}, false
+	}
+	result, ok := ctx.Images[service.Image]
+	return result, ok
+}
+
+var checkLatestTag = Check{
+	Code:        "PW040",
+	Name:        "Latest Image Tag",
+	Description: "Check for images without a tag or with the latest tag",
+	Severity:    SeverityWarning,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			named, ok := pulledImage(service)
+			if !ok {
+				continue
+			}
+			if _, digested := named.(reference.Digested); digested {
+				continue
+			}
+
+			message := fmt.Sprintf("Image %s has no tag", service.Image)
+			if tagged, ok := named.(reference.Tagged); ok {
+				if tagged.Tag() != "latest" {
+					continue
+				}
+				message = fmt.Sprintf("Image %s uses the latest tag", service.Image)
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    message,
+				Context:    "Replicas and rollbacks can pull different versions of the image, and the deployed version cannot be told from the compose file.",
+				Suggestion: "Pin a version tag, e.g. " + reference.FamiliarName(named) + ":1.2.3, or a digest.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkDockerHubImage = Check{
+	Code:        "PW041",
+	Name:        "Docker Hub Image",
+	Description: "Check for images pulled from Docker Hub without a registry prefix",
+	Severity:    SeverityInfo,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			named, ok := pulledImage(service)
+			if !ok || reference.Domain(named) != dockerHubDomain || service.Image != reference.FamiliarString(named) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    fmt.Sprintf("Image %s is pulled from Docker Hub", service.Image),
+				Context:    "Docker Hub rate limits anonymous pulls, which can delay deploys and restarts.",
+				Suggestion: "Pull from a mirror, e.g. mirror.gcr.io/" + reference.Path(named) + ", or from the registry the project publishes to.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkImageExists = Check{
+	Code:        "PW042",
+	Name:        "Image Not Found",
+	Description: "Check that images exist in their registry (needs registry access)",
+	Severity:    SeverityError,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			result, ok := lookup(ctx, service)
+			if !ok || !errors.Is(result.Err, registry.ErrNotFound) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    fmt.Sprintf("Image %s does not exist", service.Image),
+				Suggestion: "Check the image name and tag. Private images need registry credentials configured in Portway.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkImagePlatform = Check{
+	Code:        "PW043",
+	Name:        "Missing linux/amd64 Image",
+	Description: "Check that images have a linux/amd64 variant (needs registry access)",
+	Severity:    SeverityError,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			result, ok := lookup(ctx, service)
+			if !ok || result.Image == nil || len(result.Image.Platforms) == 0 || result.Image.HasPlatform("linux", "amd64") {
+				continue
+			}
+
+			platforms := make([]string, 0, len(result.Image.Platforms))
+			for _, platform := range result.Image.Platforms {
+				platforms = append(platforms, platform.String())
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    fmt.Sprintf("Image %s has no linux/amd64 variant", service.Image),
+				Context:    "Portway runs services on linux/amd64 nodes. The image is built for " + joinLimited(platforms, 5) + ".",
+				Suggestion: "Use a multi-platform tag, or build the image with --platform linux/amd64.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkImageSize = Check{
+	Code:        "PW044",
+	Name:        "Large Image",
+	Description: "Check for images larger than the lint max-image-size (needs registry access)",
+	Severity:    SeverityWarning,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		limit, err := ctx.Policy.ImageSizeLimit()
+		if err != nil {
+			return nil
+		}
+
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			result, ok := lookup(ctx, service)
+			if !ok || result.Image == nil || result.Image.Size <= limit {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    fmt.Sprintf("Image %s is %s compressed, more than %s", service.Image, formatBytes(result.Image.Size), formatBytes(limit)),
+				Context:    "Large images slow down deploys, scaling and the restart of services on new nodes.",
+				Suggestion: "Use a slim or alpine variant, or raise lint.max-image-size in .portway.yaml.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkBuildImageName = Check{
+	Code:        "PW045",
+	Name:        "Build Overwrites Public Image",
+	Description: "Check for services that build an image named like a public image",
+	Severity:    SeverityWarning,
+	Category:    "images",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if service.Build == nil || service.Image == "" {
+				continue
+			}
+			named, err := reference.ParseNormalizedNamed(service.Image)
+			if err != nil || !isPublicImage(ctx, service.Image, named) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".image",
+				Message:    fmt.Sprintf("Built image is named %s, like a public image", service.Image),
+				Context:    "The build is tagged with the public name, so it can be replaced by the public image when it is pulled.",
+				Suggestion: "Remove image to let Portway name the build, or use a name in a namespace you own.",
+			})
+		}
+		return issues
+	},
+}
+
+// isPublicImage reports whether an image name belongs to a public image.
+// Without registry access only official Docker Hub images are known.
+func isPublicImage(ctx *Context, image string, named reference.Named) bool {
+	if result, ok := ctx.Images[image]; ok && result.Err == nil {
+		return true
+	}
+	return reference.Domain(named) == dockerHubDomain && strings.HasPrefix(reference.Path(named), "library/")
+}
+
+// joinLimited joins values, listing at most limit of them
+func joinLimited(values []string, limit int) string {
+	if len(values) <= limit {
+		return strings.Join(values, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(values[:limit], ", "), len(values)-limit)
+}
+
+func init() {
+	Register(checkLatestTag)
+	Register(checkDockerHubImage)
+	Register(checkImageExists)
+	Register(checkImagePlatform)
+	Register(checkImageSize)
+	Register(checkBuildImageName)
+}
@@ -0,0 +1,104 @@
+package lint
+
+import (
+	"cli/pkg/registry"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// newImageRegistry serves three images: small:1.0 and large:1.0 for
+// linux/amd64 and arm:1.0 for linux/arm64 only. Anything else is missing.
+func newImageRegistry(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	manifest := func(size int64) map[string]any {
+		return map[string]any{
+			"mediaType": "application/vnd.oci.image.manifest.v1+json",
+			"config":    map[string]any{"digest": "sha256:config", "size": 0},
+			"layers":    []map[string]any{{"digest": "sha256:layer", "size": size}},
+		}
+	}
+	manifests := map[string]any{
+		"/v2/team/small/manifests/1.0": manifest(100),
+		"/v2/team/large/manifests/1.0": manifest(5 << 20),
+		"/v2/team/arm/manifests/1.0": map[string]any{
+			"mediaType": "application/vnd.oci.image.index.v1+json",
+			"manifests": []map[string]any{{"digest": "sha256:arm64", "platform": map[string]string{"os": "linux", "architecture": "arm64"}}},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/blobs/sha256:config") {
+			json.NewEncoder(w).Encode(map[string]string{"os": "linux", "architecture": "amd64"})
+			return
+		}
+		body, ok := manifests[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", body.(map[string]any)["mediaType"].(string))
+		if r.Method == http.MethodGet {
+			json.NewEncoder(w).Encode(body)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestImageChecks(t *testing.T) {
+	server := newImageRegistry(t)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	client := registry.NewClient()
+	client.Endpoint = func(string) string { return server.URL }
+
+	project := &types.Project{Services: types.Services{}}
+	for _, name := range []string{"small", "large", "arm", "missing"} {
+		project.Services[name] = types.ServiceConfig{Name: name, Image: host + "/team/" + name + ":1.0"}
+	}
+
+	checks := NewRegistry()
+	checks.Register(checkImageExists)
+	checks.Register(checkImagePlatform)
+	checks.Register(checkImageSize)
+
+	issues := RunLocal(context.Background(), project, Options{
+		Registry: checks,
+		Images:   client,
+		Policy:   &Policy{MaxImageSize: "1MiB"},
+	})
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.Code+" "+issue.Service)
+	}
+	slices.Sort(got)
+
+	want := []string{"PW042 missing", "PW043 arm", "PW044 large"}
+	if !slices.Equal(got, want) {
+		t.Errorf("issues = %q, want %q", got, want)
+	}
+}
+
+func TestImageChecksWithoutRegistry(t *testing.T) {
+	project := &types.Project{Services: types.Services{
+		"web": {Name: "web", Image: "registry.invalid/team/web:1.0"},
+	}}
+
+	checks := NewRegistry()
+	checks.Register(checkImageExists)
+	checks.Register(checkImagePlatform)
+	checks.Register(checkImageSize)
+
+	if issues := RunLocal(context.Background(), project, Options{Registry: checks}); len(issues) != 0 {
+		t.Errorf("issues = %v, want none without registry access", issues)
+	}
+}
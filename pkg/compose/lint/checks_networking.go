@@ -0,0 +1,366 @@
+package lint
+
+import (
+	"cli/pkg/compose"
+	"cli/pkg/yamledit"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"gopkg.in/yaml.v3"
+)
+
+// dns1123Label matches names Kubernetes accepts for services
+var dns1123Label = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// maxLabelLength is the longest DNS-1123 label
+const maxLabelLength = 63
+
+// portRange matches a port range such as 8000-8010
+var portRange = regexp.MustCompile(`^\d+-\d+$`)
+
+// invalidLabelChars matches runs of characters not allowed in a label
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// dns1123Name suggests a valid name for an invalid service name
+func dns1123Name(name string) string {
+	name = strings.ToLower(name)
+	name = invalidLabelChars.ReplaceAllString(name, "-")
+	name = strings.Trim(name, "-")
+	if len(name) > maxLabelLength {
+		name = strings.TrimRight(name[:maxLabelLength], "-")
+	}
+	return name
+}
+
+var checkServiceNames = Check{
+	Code:        "PW020",
+	Name:        "Invalid Service Name",
+	Description: "Check for service names that are not DNS-1123 labels",
+	Severity:    SeverityError,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if len(service.Name) <= maxLabelLength && dns1123Label.MatchString(service.Name) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name,
+				Message:    fmt.Sprintf("Service name %q is not a valid DNS-1123 label", service.Name),
+				Context:    "Services are reached by their name, which must be at most 63 lowercase letters, digits and dashes, starting and ending with a letter or digit.",
+				Suggestion: fmt.Sprintf("Rename the service, e.g. to %q, and update the services that refer to it.", dns1123Name(service.Name)),
+			})
+		}
+		return issues
+	},
+}
+
+var checkNetworkMode = Check{
+	Code:        "PW021",
+	Name:        "Network Mode",
+	Description: "Check for host, service and container network modes",
+	Severity:    SeverityError,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			mode := service.NetworkMode
+			if mode != "host" && !strings.HasPrefix(mode, "service:") && !strings.HasPrefix(mode, "container:") {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".network_mode",
+				Message:    fmt.Sprintf("network_mode %s is not supported", mode),
+				Context:    "Every service gets its own network namespace on the cluster and cannot share the network of the node or of another service.",
+				Suggestion: "Remove network_mode and reach other services by their service name.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkLinks = Check{
+	Code:        "PW022",
+	Name:        "Links",
+	Description: "Check for links and external_links",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if len(service.Links) > 0 {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".links",
+					Message:    "links are ignored",
+					Context:    "Link aliases are not created on the cluster, so only the service names resolve.",
+					Suggestion: "Remove links and use the service names. Use depends_on to control start order.",
+				})
+			}
+			if len(service.ExternalLinks) > 0 {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".external_links",
+					Message:    "external_links are not supported",
+					Context:    "Containers outside the project do not exist on the cluster.",
+					Suggestion: "Deploy the linked service in the project, or reach it through its public address.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkNetworkAddressing = Check{
+	Code:        "PW023",
+	Name:        "Network Aliases and Static IPs",
+	Description: "Check for network aliases and static IP addresses",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			for _, name := range sortedKeys(service.Networks) {
+				network := service.Networks[name]
+				if network == nil {
+					continue
+				}
+				field := "services." + service.Name + ".networks." + name
+				if len(network.Aliases) > 0 {
+					issues = append(issues, Issue{
+						Service:    service.Name,
+						Field:      field + ".aliases",
+						Message:    fmt.Sprintf("Network aliases %s are not created", strings.Join(network.Aliases, ", ")),
+						Context:    "All services share one network on the cluster and are reached by their service name only.",
+						Suggestion: "Rename the service to the alias other services use, or update them to use the service name.",
+					})
+				}
+				if network.Ipv4Address != "" || network.Ipv6Address != "" {
+					address := network.Ipv4Address
+					key := "ipv4_address"
+					if address == "" {
+						address, key = network.Ipv6Address, "ipv6_address"
+					}
+					issues = append(issues, Issue{
+						Service:    service.Name,
+						Field:      field + "." + key,
+						Message:    fmt.Sprintf("Static address %s cannot be assigned", address),
+						Context:    "Pod addresses are assigned by the cluster and change when the service restarts.",
+						Suggestion: "Reach the service by its name instead of its address.",
+					})
+				}
+			}
+		}
+		return issues
+	},
+}
+
+var checkUDPPorts = Check{
+	Code:        "PW024",
+	Name:        "UDP Port",
+	Description: "Check for UDP ports",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			// Ranges are expanded on load, so entries are not indexed as written
+			for _, port := range service.Ports {
+				if port.Protocol != "udp" {
+					continue
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".ports",
+					Message:    fmt.Sprintf("UDP port %d is not routed from outside the cluster", port.Target),
+					Context:    "The Portway ingress routes HTTP and TCP traffic. UDP ports are only reachable by the other services of the project.",
+					Suggestion: "Use expose for ports only used inside the project, or serve the protocol over TCP.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkPortRanges = Check{
+	Code:        "PW025",
+	Name:        "Port Range",
+	Description: "Check for port ranges",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			for _, port := range portRanges(ctx, service) {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".ports",
+					Position:   port.position,
+					Message:    fmt.Sprintf("Port range %s is published", port.spec),
+					Context:    "Each port of a range becomes a separate port on the load balancer of the service.",
+					Suggestion: "Publish only the ports clients connect to, and use expose for the others.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+// publishedRange is a ports entry written as a range
+type publishedRange struct {
+	spec     string
+	position compose.Position
+}
+
+// portRanges returns the ports entries of a service written as a range.
+// Ranges are expanded when compose loads a project, so they are looked up
+// in the compose files when available.
+func portRanges(ctx *Context, service types.ServiceConfig) []publishedRange {
+	var ranges []publishedRange
+	if ctx.Sources == nil {
+		for _, port := range service.Ports {
+			if portRange.MatchString(port.Published) {
+				ranges = append(ranges, publishedRange{spec: port.Published})
+			}
+		}
+		return ranges
+	}
+
+	for _, ports := range ctx.Sources.Values("services." + service.Name + ".ports") {
+		for _, item := range ports.Node.Content {
+			if spec := portRangeEntry(item); spec != "" {
+				ranges = append(ranges, publishedRange{
+					spec:     spec,
+					position: compose.Position{File: ports.Position.File, Line: item.Line, Column: item.Column},
+				})
+			}
+		}
+	}
+	return ranges
+}
+
+// portRangeEntry returns an entry of a ports list when it uses a range
+func portRangeEntry(item *yaml.Node) string {
+	switch item.Kind {
+	case yaml.ScalarNode:
+		spec, _, _ := strings.Cut(item.Value, "/")
+		for _, part := range strings.Split(spec, ":") {
+			if portRange.MatchString(part) {
+				return item.Value
+			}
+		}
+	case yaml.MappingNode:
+		for _, key := range []string{"published", "target"} {
+			if value := yamledit.MappingValue(item, key); value != nil && portRange.MatchString(value.Value) {
+				return value.Value
+			}
+		}
+	}
+	return ""
+}
+
+var checkExtraHosts = Check{
+	Code:        "PW026",
+	Name:        "Extra Hosts",
+	Description: "Check for extra_hosts entries",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if len(service.ExtraHosts) == 0 {
+				continue
+			}
+
+			var hostGateway []string
+			for _, host := range sortedKeys(service.ExtraHosts) {
+				for _, address := range service.ExtraHosts[host] {
+					if address == "host-gateway" {
+						hostGateway = append(hostGateway, host)
+					}
+				}
+			}
+
+			issue := Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".extra_hosts",
+				Message:    "extra_hosts entries must be reachable from the cluster",
+				Context:    "The entries are added to /etc/hosts of the service. Addresses on your machine or your local network are not reachable from the cluster.",
+				Suggestion: "Use public hostnames or the names of services in the project instead.",
+			}
+			if len(hostGateway) > 0 {
+				issue.Message = fmt.Sprintf("host-gateway for %s does not point at your machine", strings.Join(hostGateway, ", "))
+				issue.Context = "On the cluster there is no Docker host, so host-gateway cannot reach services running on your machine."
+			}
+			issues = append(issues, issue)
+		}
+		return issues
+	},
+}
+
+var checkDuplicatePorts = Check{
+	Code:        "PW027",
+	Name:        "Duplicate Published Port",
+	Description: "Check for the same port published by more than one service",
+	Severity:    SeverityError,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		type published struct {
+			port     string
+			protocol string
+		}
+
+		users := map[published][]string{}
+		for _, name := range sortedKeys(ctx.Project.Services) {
+			service := ctx.Project.Services[name]
+			for _, port := range service.Ports {
+				if port.Published == "" {
+					continue
+				}
+				key := published{port: port.Published, protocol: port.Protocol}
+				users[key] = append(users[key], service.Name)
+			}
+		}
+
+		var issues []Issue
+		for key, services := range users {
+			if len(services) < 2 {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    services[len(services)-1],
+				Field:      "services." + services[len(services)-1] + ".ports",
+				Message:    fmt.Sprintf("Port %s/%s is published by %s", key.port, key.protocol, strings.Join(services, ", ")),
+				Context:    "Published ports share the public address of the environment, so each port can only be used once.",
+				Suggestion: "Publish a different port, or route the services by domain instead.",
+			})
+		}
+		return issues
+	},
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func init() {
+	Register(checkServiceNames)
+	Register(checkNetworkMode)
+	Register(checkLinks)
+	Register(checkNetworkAddressing)
+	Register(checkUDPPorts)
+	Register(checkPortRanges)
+	Register(checkExtraHosts)
+	Register(checkDuplicatePorts)
+}
@@ -0,0 +1,114 @@
+package lint
+
+import (
+	"cli/pkg/yamledit"
+	"slices"
+	"strconv"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/distribution/reference"
+	"gopkg.in/yaml.v3"
+)
+
+var checkPorts = Check{
+	Code:        "PW003",
+	Name:        "Missing Port Configuration",
+	Description: "Check for services without port or expose configuration",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			// Skip check if restart is set to "no"
+			if service.Restart == "no" {
+				continue
+			}
+
+			if len(service.Ports) == 0 && len(service.Expose) == 0 {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".ports",
+					Message:    "Service does not expose any ports",
+					Suggestion: "Add ports or expose configuration to make the service accessible. If this service is not expected to expose ports, you can safely ignore this warning.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+// internalImages are images of services that are only reached by other
+// services of the project
+var internalImages = []string{
+	"postgres", "postgis/postgis", "mysql", "mariadb", "mongo", "redis", "valkey/valkey",
+	"memcached", "rabbitmq", "elasticsearch", "opensearchproject/opensearch", "clickhouse/clickhouse-server",
+}
+
+// isInternalService reports whether the service runs a database, cache or
+// broker image
+func isInternalService(service types.ServiceConfig) bool {
+	named, err := reference.ParseNormalizedNamed(service.Image)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(internalImages, reference.FamiliarName(named))
+}
+
+var checkInternalPorts = Check{
+	Code:        "PW004",
+	Name:        "Published Internal Port",
+	Description: "Check for databases, caches and brokers publishing ports",
+	Severity:    SeverityWarning,
+	Category:    "networking",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if len(service.Ports) == 0 || !isInternalService(service) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".ports",
+				Message:    "Internal service " + service.Image + " publishes ports",
+				Suggestion: "Use expose instead so only the services of the project can reach it.",
+			})
+		}
+		return issues
+	},
+	Fix: func(ctx *FixContext) bool {
+		if !yamledit.RemoveKey(ctx.Node, "ports") {
+			return false
+		}
+
+		expose := yamledit.MappingValue(ctx.Node, "expose")
+		if expose == nil || expose.Kind != yaml.SequenceNode {
+			expose = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			yamledit.SetKey(ctx.Node, "expose", expose)
+		}
+
+		existing := map[string]bool{}
+		for _, item := range expose.Content {
+			existing[item.Value] = true
+		}
+		for _, port := range ctx.Service.Ports {
+			value := strconv.FormatUint(uint64(port.Target), 10)
+			if port.Protocol != "" && port.Protocol != "tcp" {
+				value += "/" + port.Protocol
+			}
+			if existing[value] {
+				continue
+			}
+			existing[value] = true
+
+			node := scalarNode(value)
+			node.Style = yaml.DoubleQuotedStyle
+			expose.Content = append(expose.Content, node)
+		}
+		return true
+	},
+}
+
+func init() {
+	Register(checkPorts)
+	Register(checkInternalPorts)
+}
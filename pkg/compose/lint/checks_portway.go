@@ -0,0 +1,267 @@
+package lint
+
+import (
+	"cli/pkg/compose"
+	"cli/pkg/yamledit"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// portwayService is a service with an x-portway extension
+type portwayService struct {
+	types.ServiceConfig
+	Portway *compose.ServiceExtension
+}
+
+// portwayServices returns the services with an x-portway extension, sorted
+// by name. Extensions that cannot be decoded are reported by PW080.
+func portwayServices(ctx *Context) []portwayService {
+	var services []portwayService
+	for _, name := range sortedServiceNames(ctx.Project) {
+		service := ctx.Project.Services[name]
+		if _, ok := service.Extensions[compose.PortwayExtensionKey]; !ok {
+			continue
+		}
+		if ext, err := compose.GetServiceExtension(service); err == nil {
+			services = append(services, portwayService{ServiceConfig: service, Portway: ext})
+		}
+	}
+	return services
+}
+
+func sortedServiceNames(project *types.Project) []string {
+	names := make([]string, 0, len(project.Services))
+	for name := range project.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// containerPorts returns the container ports of a service, from ports and
+// expose
+func containerPorts(service types.ServiceConfig) []uint32 {
+	var ports []uint32
+	for _, port := range service.Ports {
+		ports = append(ports, port.Target)
+	}
+	for _, expose := range service.Expose {
+		expose, _, _ = strings.Cut(expose, "/")
+		start, end, _ := strings.Cut(expose, "-")
+		first, err := strconv.ParseUint(start, 10, 32)
+		if err != nil {
+			continue
+		}
+		last := first
+		if end != "" {
+			if last, err = strconv.ParseUint(end, 10, 32); err != nil {
+				continue
+			}
+		}
+		for port := first; port <= last; port++ {
+			ports = append(ports, uint32(port))
+		}
+	}
+	slices.Sort(ports)
+	return slices.Compact(ports)
+}
+
+func formatPorts(ports []uint32) string {
+	parts := make([]string, 0, len(ports))
+	for _, port := range ports {
+		parts = append(parts, strconv.FormatUint(uint64(port), 10))
+	}
+	return joinLimited(parts, 5)
+}
+
+var checkPortwayExtension = Check{
+	Code:        "PW080",
+	Name:        "Invalid x-portway Extension",
+	Description: "Check x-portway extensions against the schema of Portway settings",
+	Severity:    SeverityError,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		errs, err := compose.ValidateExtensions(ctx.Project)
+		if err != nil {
+			return nil
+		}
+
+		var issues []Issue
+		for _, e := range errs {
+			message := fmt.Sprintf("Invalid %s extension: %s", compose.PortwayExtensionKey, e.Message)
+			if len(e.Path) > 0 {
+				message = fmt.Sprintf("Invalid %s setting %s: %s", compose.PortwayExtensionKey, strings.Join(e.Path, "."), e.Message)
+			}
+			issues = append(issues, Issue{
+				Service:    e.Service,
+				Field:      e.Field(),
+				Message:    message,
+				Context:    "x-portway settings are uploaded with the compose file and Portway rejects values it cannot read.",
+				Suggestion: "Run deploy validate list-checks for the supported settings and their types.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkEndpointPort = Check{
+	Code:        "PW081",
+	Name:        "Unknown Endpoint Port",
+	Description: "Check that x-portway endpoints and health probes use ports of the service",
+	Severity:    SeverityError,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range portwayServices(ctx) {
+			ports := containerPorts(service.ServiceConfig)
+			unknown := func(field string, port uint32) {
+				if port == 0 || slices.Contains(ports, port) {
+					return
+				}
+				suggestion := fmt.Sprintf("Add %d to the ports or expose of the service.", port)
+				if len(ports) > 0 {
+					suggestion = fmt.Sprintf("Use one of the ports %s, or add %d to the ports or expose of the service.", formatPorts(ports), port)
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + "." + compose.PortwayExtensionKey + "." + field,
+					Message:    fmt.Sprintf("Port %d is not a port of the service", port),
+					Context:    "Portway routes traffic and probes only to the container ports declared in ports or expose.",
+					Suggestion: suggestion,
+				})
+			}
+
+			for i, endpoint := range service.Portway.Endpoints {
+				unknown(fmt.Sprintf("endpoints.%d.port", i), endpoint.Port)
+			}
+			if service.Portway.Health != nil {
+				unknown("health.port", service.Portway.Health.Port)
+			}
+		}
+		return issues
+	},
+}
+
+var checkAutoscalingRange = Check{
+	Code:        "PW082",
+	Name:        "Invalid Autoscaling Range",
+	Description: "Check that autoscaling min-replicas is not above max-replicas",
+	Severity:    SeverityError,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range portwayServices(ctx) {
+			scaling := service.Portway.Autoscaling
+			if scaling == nil || scaling.MaxReplicas == 0 || max(scaling.MinReplicas, 1) <= scaling.MaxReplicas {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + "." + compose.PortwayExtensionKey + ".autoscaling.min-replicas",
+				Message:    fmt.Sprintf("Autoscaling min-replicas %d is above max-replicas %d", scaling.MinReplicas, scaling.MaxReplicas),
+				Context:    "Portway cannot create an autoscaler without a valid range, so the deploy fails.",
+				Suggestion: "Lower min-replicas or raise max-replicas.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkReplicasWithAutoscaling = Check{
+	Code:        "PW083",
+	Name:        "Replicas With Autoscaling",
+	Description: "Check for services setting deploy.replicas and x-portway autoscaling",
+	Severity:    SeverityWarning,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range portwayServices(ctx) {
+			if service.Portway.Autoscaling == nil || service.Deploy == nil || service.Deploy.Replicas == nil {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".deploy.replicas",
+				Message:    "Service sets deploy.replicas and autoscaling",
+				Context:    "The autoscaler owns the replica count, so deploy.replicas only applies until it first scales.",
+				Suggestion: "Remove deploy.replicas and set autoscaling.min-replicas instead.",
+			})
+		}
+		return issues
+	},
+	Fix: func(ctx *FixContext) bool {
+		deploy := yamledit.MappingValue(ctx.Node, "deploy")
+		return deploy != nil && yamledit.RemoveKey(deploy, "replicas")
+	},
+}
+
+var checkDomainsWithoutEndpoint = Check{
+	Code:        "PW084",
+	Name:        "Domains Without Endpoint",
+	Description: "Check for x-portway domains on services without endpoints",
+	Severity:    SeverityError,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range portwayServices(ctx) {
+			if len(service.Portway.Domains) == 0 || len(service.Portway.Endpoints) > 0 {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + "." + compose.PortwayExtensionKey + ".domains",
+				Message:    "Service has custom domains but no endpoints",
+				Context:    "Domains are routed to the endpoints of the service, so without one they serve nothing.",
+				Suggestion: "Add an endpoint with the port the service listens on, e.g. endpoints: [{port: 8080}].",
+			})
+		}
+		return issues
+	},
+}
+
+var checkDuplicateDomain = Check{
+	Code:        "PW085",
+	Name:        "Duplicate Domain",
+	Description: "Check for custom domains used by more than one service",
+	Severity:    SeverityError,
+	Category:    "portway",
+	Run: func(ctx *Context) []Issue {
+		owners := map[string]string{}
+		var issues []Issue
+		for _, service := range portwayServices(ctx) {
+			for i, domain := range service.Portway.Domains {
+				key := strings.ToLower(domain)
+				owner, taken := owners[key]
+				if !taken {
+					owners[key] = service.Name
+					continue
+				}
+				if owner == service.Name {
+					continue
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      fmt.Sprintf("services.%s.%s.domains.%d", service.Name, compose.PortwayExtensionKey, i),
+					Message:    fmt.Sprintf("Domain %s is also used by service %s", domain, owner),
+					Context:    "A domain routes to a single service.",
+					Suggestion: "Keep the domain on one service and route the other with an endpoint path.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkPortwayExtension)
+	Register(checkEndpointPort)
+	Register(checkAutoscalingRange)
+	Register(checkReplicasWithAutoscaling)
+	Register(checkDomainsWithoutEndpoint)
+	Register(checkDuplicateDomain)
+}
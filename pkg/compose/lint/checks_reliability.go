@@ -0,0 +1,110 @@
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// hasHealthcheck reports whether the service declares an enabled healthcheck
+func hasHealthcheck(service types.ServiceConfig) bool {
+	return service.HealthCheck != nil && !service.HealthCheck.Disable && len(service.HealthCheck.Test) > 0 && service.HealthCheck.Test[0] != "NONE"
+}
+
+// servesTraffic reports whether the service publishes or exposes ports
+func servesTraffic(service types.ServiceConfig) bool {
+	return len(service.Ports) > 0 || len(service.Expose) > 0
+}
+
+var checkHealthcheck = Check{
+	Code:        "PW033",
+	Name:        "Missing Healthcheck",
+	Description: "Check for services with ports but no healthcheck",
+	Severity:    SeverityWarning,
+	Category:    "reliability",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if !servesTraffic(service) || hasHealthcheck(service) {
+				continue
+			}
+
+			message := "Service has no healthcheck"
+			if service.HealthCheck != nil {
+				message = "Service healthcheck is disabled"
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".healthcheck",
+				Message:    message,
+				Context:    "Without a healthcheck Portway only checks that the port accepts connections, so a hung service keeps receiving traffic.",
+				Suggestion: "Add a healthcheck that tests the service itself, e.g. test: [\"CMD\", \"curl\", \"-f\", \"http://localhost/health\"].",
+			})
+		}
+		return issues
+	},
+}
+
+var checkRestartPolicy = Check{
+	Code:        "PW034",
+	Name:        "Restart Disabled",
+	Description: "Check for restart: no on services with ports",
+	Severity:    SeverityWarning,
+	Category:    "reliability",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if service.Restart != types.RestartPolicyNo || !servesTraffic(service) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".restart",
+				Message:    "Long-running service is not restarted when it exits",
+				Context:    "The service serves traffic on a port, so it stays down after a crash until the next deploy.",
+				Suggestion: "Use restart: unless-stopped, or remove ports if the service is a one-off job.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkHealthyDependencies = Check{
+	Code:        "PW035",
+	Name:        "Healthy Dependency Without Healthcheck",
+	Description: "Check for depends_on service_healthy on services without a healthcheck",
+	Severity:    SeverityError,
+	Category:    "reliability",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			var unhealthy []string
+			for _, name := range sortedKeys(service.DependsOn) {
+				if service.DependsOn[name].Condition != types.ServiceConditionHealthy {
+					continue
+				}
+				if dependency, ok := ctx.Project.Services[name]; ok && !hasHealthcheck(dependency) {
+					unhealthy = append(unhealthy, name)
+				}
+			}
+			if len(unhealthy) == 0 {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".depends_on",
+				Message:    fmt.Sprintf("Waits for %s to be healthy, which has no healthcheck", strings.Join(unhealthy, ", ")),
+				Context:    "A service without a healthcheck never becomes healthy, so this service never starts.",
+				Suggestion: "Add a healthcheck to the dependency, or use condition: service_started.",
+			})
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkHealthcheck)
+	Register(checkRestartPolicy)
+	Register(checkHealthyDependencies)
+}
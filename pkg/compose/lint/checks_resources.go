@@ -0,0 +1,206 @@
+package lint
+
+import (
+	"cli/pkg/yamledit"
+	"fmt"
+	"strconv"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Default limits added by the missing limits fixer
+const (
+	defaultCPULimit    = "0.5"
+	defaultMemoryLimit = "512M"
+)
+
+// resources returns the resources of a service, empty when not set
+func resources(service types.ServiceConfig) types.Resources {
+	if service.Deploy == nil {
+		return types.Resources{}
+	}
+	return service.Deploy.Resources
+}
+
+// formatBytes formats a memory size with a binary unit
+func formatBytes(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	div, exp := int64(unit), 0
+	for n := bytes / unit; n >= unit && exp < 3; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.4g%ciB", float64(bytes)/float64(div), "KMGT"[exp])
+}
+
+// formatCPUs formats a CPU count as written in compose files
+func formatCPUs(cpus types.NanoCPUs) string {
+	return strconv.FormatFloat(float64(cpus), 'g', -1, 32)
+}
+
+var checkResourceLimits = Check{
+	Code:        "PW005",
+	Name:        "Missing Resource Limits",
+	Description: "Check for services without CPU and memory limits",
+	Severity:    SeverityWarning,
+	Category:    "resources",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			limits := resources(service).Limits
+			if limits != nil && limits.MemoryBytes > 0 && limits.NanoCPUs > 0 {
+				continue
+			}
+
+			message := "Service has no resource limits"
+			if limits != nil && limits.MemoryBytes == 0 {
+				message = "Service has no memory limit"
+			} else if limits != nil {
+				message = "Service has no CPU limit"
+			}
+
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".deploy.resources.limits",
+				Message:    message,
+				Suggestion: "Set deploy.resources.limits so one service cannot starve the others, e.g. cpus: \"" + defaultCPULimit + "\" and memory: " + defaultMemoryLimit + ".",
+			})
+		}
+		return issues
+	},
+	Fix: func(ctx *FixContext) bool {
+		limits := ensureMapping(ensureMapping(ensureMapping(ctx.Node, "deploy"), "resources"), "limits")
+
+		changed := false
+		if yamledit.MappingValue(limits, "cpus") == nil {
+			cpus := scalarNode(defaultCPULimit)
+			cpus.Style = yaml.DoubleQuotedStyle
+			yamledit.SetKey(limits, "cpus", cpus)
+			changed = true
+		}
+		if yamledit.MappingValue(limits, "memory") == nil {
+			yamledit.SetKey(limits, "memory", scalarNode(defaultMemoryLimit))
+			changed = true
+		}
+		return changed
+	},
+}
+
+var checkResourceReservations = Check{
+	Code:        "PW030",
+	Name:        "Missing Resource Reservations",
+	Description: "Check for services without CPU and memory reservations",
+	Severity:    SeverityWarning,
+	Category:    "resources",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if resources(service).Reservations != nil {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      "services." + service.Name + ".deploy.resources.reservations",
+				Message:    "Service has no resource reservations",
+				Context:    "Without reservations the service is scheduled as if it needed nothing, and is the first to be evicted when a node runs low on memory.",
+				Suggestion: "Set deploy.resources.reservations to what the service needs at rest, e.g. cpus: \"0.1\" and memory: 64M.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkReservationsWithinLimits = Check{
+	Code:        "PW031",
+	Name:        "Reservations Exceed Limits",
+	Description: "Check for reservations greater than limits",
+	Severity:    SeverityError,
+	Category:    "resources",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			limits, reservations := resources(service).Limits, resources(service).Reservations
+			if limits == nil || reservations == nil {
+				continue
+			}
+
+			field := "services." + service.Name + ".deploy.resources.reservations"
+			if limits.NanoCPUs > 0 && reservations.NanoCPUs > limits.NanoCPUs {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      field + ".cpus",
+					Message:    fmt.Sprintf("CPU reservation %s is greater than the limit %s", formatCPUs(reservations.NanoCPUs), formatCPUs(limits.NanoCPUs)),
+					Suggestion: "Lower the reservation or raise the limit. The service cannot be scheduled otherwise.",
+				})
+			}
+			if limits.MemoryBytes > 0 && reservations.MemoryBytes > limits.MemoryBytes {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      field + ".memory",
+					Message:    fmt.Sprintf("Memory reservation %s is greater than the limit %s", formatBytes(int64(reservations.MemoryBytes)), formatBytes(int64(limits.MemoryBytes))),
+					Suggestion: "Lower the reservation or raise the limit. The service cannot be scheduled otherwise.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkPlanLimits = Check{
+	Code:        "PW032",
+	Name:        "Plan Limit Exceeded",
+	Description: "Check for limits and replicas above the maximums of the organization plan (needs the API)",
+	Severity:    SeverityError,
+	Category:    "resources",
+	Run: func(ctx *Context) []Issue {
+		if ctx.Plan == nil {
+			return nil
+		}
+
+		plan := ctx.Plan
+		suggestion := fmt.Sprintf("Lower the value, or upgrade the %s plan of the organization.", plan.Plan)
+
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			field := "services." + service.Name + ".deploy"
+			if limits := resources(service).Limits; limits != nil {
+				if plan.MaxCpusPerService > 0 && float32(limits.NanoCPUs) > plan.MaxCpusPerService {
+					issues = append(issues, Issue{
+						Service:    service.Name,
+						Field:      field + ".resources.limits.cpus",
+						Message:    fmt.Sprintf("CPU limit %s is above the plan maximum of %s", formatCPUs(limits.NanoCPUs), formatCPUs(types.NanoCPUs(plan.MaxCpusPerService))),
+						Suggestion: suggestion,
+					})
+				}
+				if plan.MaxMemoryPerServiceBytes > 0 && int64(limits.MemoryBytes) > plan.MaxMemoryPerServiceBytes {
+					issues = append(issues, Issue{
+						Service:    service.Name,
+						Field:      field + ".resources.limits.memory",
+						Message:    fmt.Sprintf("Memory limit %s is above the plan maximum of %s", formatBytes(int64(limits.MemoryBytes)), formatBytes(plan.MaxMemoryPerServiceBytes)),
+						Suggestion: suggestion,
+					})
+				}
+			}
+			if service.Deploy != nil && service.Deploy.Replicas != nil && plan.MaxReplicasPerService > 0 && *service.Deploy.Replicas > plan.MaxReplicasPerService {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      field + ".replicas",
+					Message:    fmt.Sprintf("%d replicas is above the plan maximum of %d", *service.Deploy.Replicas, plan.MaxReplicasPerService),
+					Suggestion: suggestion,
+				})
+			}
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkResourceLimits)
+	Register(checkResourceReservations)
+	Register(checkReservationsWithinLimits)
+	Register(checkPlanLimits)
+}
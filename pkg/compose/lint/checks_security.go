@@ -0,0 +1,120 @@
+package lint
+
+import (
+	"cli/pkg/secrets"
+	"cli/pkg/yamledit"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var checkPrivilegedMode = Check{
+	Code:        "PW001",
+	Name:        "Privileged Mode",
+	Description: "Check for service privileged mode",
+	Severity:    SeverityWarning,
+	Category:    "security",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if service.Privileged {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".privileged",
+					Message:    "Privileged mode is not supported",
+					Suggestion: "Consider using a non-privileged mode",
+				})
+			}
+		}
+		return issues
+	},
+	Fix: func(ctx *FixContext) bool {
+		return yamledit.RemoveKey(ctx.Node, "privileged")
+	},
+}
+
+// dangerousCapabilities are Linux capabilities Portway does not grant
+var dangerousCapabilities = map[string]bool{
+	"SYS_ADMIN":  true,
+	"NET_ADMIN":  true,
+	"ALL":        true,
+	"SYS_MODULE": true,
+	"SYS_RAWIO":  true,
+	"SYS_PTRACE": true,
+}
+
+var checkCapabilities = Check{
+	Code:        "PW002",
+	Name:        "Capabilities",
+	Description: "Check for dangerous Linux capabilities",
+	Severity:    SeverityWarning,
+	Category:    "security",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			var dangerousCapsFound []string
+			for _, cap := range service.CapAdd {
+				if dangerousCapabilities[cap] {
+					dangerousCapsFound = append(dangerousCapsFound, cap)
+				}
+			}
+			if len(dangerousCapsFound) > 0 {
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      "services." + service.Name + ".cap_add",
+					Message:    "These capabilities are not supported by Portway: " + strings.Join(dangerousCapsFound, ", "),
+					Suggestion: "Please remove them or use a more restrictive alternative.",
+				})
+			}
+		}
+		return issues
+	},
+	Fix: func(ctx *FixContext) bool {
+		capAdd := yamledit.MappingValue(ctx.Node, "cap_add")
+		if capAdd == nil || capAdd.Kind != yaml.SequenceNode {
+			return false
+		}
+
+		kept := capAdd.Content[:0]
+		for _, item := range capAdd.Content {
+			if !dangerousCapabilities[item.Value] {
+				kept = append(kept, item)
+			}
+		}
+		changed := len(kept) != len(capAdd.Content)
+		capAdd.Content = kept
+
+		if len(capAdd.Content) == 0 {
+			yamledit.RemoveKey(ctx.Node, "cap_add")
+		}
+		return changed
+	},
+}
+
+var checkPlaintextSecrets = Check{
+	Code:        "PW050",
+	Name:        "Plaintext Secret",
+	Description: "Check for credentials in environment, build args, labels and command",
+	Severity:    SeverityError,
+	Category:    "security",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, finding := range secrets.ScanProject(ctx.Project) {
+			issues = append(issues, Issue{
+				Service:    finding.Service,
+				Field:      finding.Field,
+				Message:    fmt.Sprintf("%s holds a plaintext credential: %s", finding.Key, secrets.Mask(finding.Value)),
+				Context:    "Detected because the " + finding.Reason + ". The compose file is uploaded to Portway as is.",
+				Suggestion: "Move the value out of the compose file: store it with portway secrets set and reference it as an external compose secret.",
+			})
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkPrivilegedMode)
+	Register(checkCapabilities)
+	Register(checkPlaintextSecrets)
+}
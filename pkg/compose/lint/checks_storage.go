@@ -0,0 +1,211 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// dockerSocket is the path of the Docker daemon socket
+const dockerSocket = "/var/run/docker.sock"
+
+// volumeField returns the field of the index-th volume of a service
+func volumeField(service types.ServiceConfig, index int) string {
+	return fmt.Sprintf("services.%s.volumes.%d", service.Name, index)
+}
+
+func isDockerSocket(volume types.ServiceVolumeConfig) bool {
+	return volume.Source == dockerSocket || volume.Source == "/run/docker.sock"
+}
+
+// projectPath returns a path relative to the project directory when it is
+// inside it
+func projectPath(ctx *Context, path string) string {
+	if rel, err := filepath.Rel(ctx.Project.WorkingDir, path); err == nil && filepath.IsLocal(rel) {
+		return "./" + filepath.ToSlash(rel)
+	}
+	return path
+}
+
+var checkBindMounts = Check{
+	Code:        "PW010",
+	Name:        "Host Bind Mount",
+	Description: "Check for bind mounts of host paths",
+	Severity:    SeverityError,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			for i, volume := range service.Volumes {
+				if volume.Type != types.VolumeTypeBind || isDockerSocket(volume) {
+					continue
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      volumeField(service, i),
+					Message:    fmt.Sprintf("Host path %s is bind mounted to %s", projectPath(ctx, volume.Source), volume.Target),
+					Context:    "Portway runs services on cluster nodes that do not have the files of your machine.",
+					Suggestion: "Use a named volume for data, or copy files into the image with COPY in a Dockerfile.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkDockerSocket = Check{
+	Code:        "PW011",
+	Name:        "Docker Socket Mount",
+	Description: "Check for services mounting the Docker socket",
+	Severity:    SeverityError,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			for i, volume := range service.Volumes {
+				if !isDockerSocket(volume) {
+					continue
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      volumeField(service, i),
+					Message:    "The Docker socket is mounted into the service",
+					Context:    "Cluster nodes do not run a Docker daemon, and access to the container runtime is not granted to services.",
+					Suggestion: "Remove the mount. Reverse proxies and service discovery are handled by Portway, so Traefik or similar labels are not needed.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkSharedVolumes = Check{
+	Code:        "PW012",
+	Name:        "Shared Named Volume",
+	Description: "Check for named volumes mounted by more than one service",
+	Severity:    SeverityWarning,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		users := map[string][]string{}
+		for _, service := range ctx.Project.Services {
+			for _, volume := range service.Volumes {
+				if volume.Type == types.VolumeTypeVolume && volume.Source != "" && !slices.Contains(users[volume.Source], service.Name) {
+					users[volume.Source] = append(users[volume.Source], service.Name)
+				}
+			}
+		}
+
+		var issues []Issue
+		for name, services := range users {
+			if len(services) < 2 {
+				continue
+			}
+			sort.Strings(services)
+			issues = append(issues, Issue{
+				Field:      "volumes." + name,
+				Message:    fmt.Sprintf("Volume %s is mounted by %s", name, strings.Join(services, ", ")),
+				Context:    "Services can run on different nodes, so a shared volume needs ReadWriteMany storage, which is slower and not available in every region.",
+				Suggestion: "Give each service its own volume, or let one service own the data and serve it to the others over the network.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkTmpfs = Check{
+	Code:        "PW013",
+	Name:        "Tmpfs Mount",
+	Description: "Check for tmpfs mounts",
+	Severity:    SeverityInfo,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			field := ""
+			if len(service.Tmpfs) > 0 {
+				field = "services." + service.Name + ".tmpfs"
+			}
+			for i, volume := range service.Volumes {
+				if field == "" && volume.Type == types.VolumeTypeTmpfs {
+					field = volumeField(service, i)
+				}
+			}
+			if field == "" {
+				continue
+			}
+			issues = append(issues, Issue{
+				Service:    service.Name,
+				Field:      field,
+				Message:    "tmpfs mounts are stored in memory",
+				Context:    "Portway mounts tmpfs as a memory-backed emptyDir, which counts against the memory limit of the service.",
+				Suggestion: "Keep tmpfs mounts small, or raise the memory limit of the service to leave room for them.",
+			})
+		}
+		return issues
+	},
+}
+
+var checkReplicatedVolumes = Check{
+	Code:        "PW014",
+	Name:        "Volume With Replicas",
+	Description: "Check for services with more than one replica mounting volumes",
+	Severity:    SeverityWarning,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for _, service := range ctx.Project.Services {
+			if service.Deploy == nil || service.Deploy.Replicas == nil || *service.Deploy.Replicas <= 1 {
+				continue
+			}
+			for i, volume := range service.Volumes {
+				if volume.Type != types.VolumeTypeVolume || volume.ReadOnly {
+					continue
+				}
+				issues = append(issues, Issue{
+					Service:    service.Name,
+					Field:      volumeField(service, i),
+					Message:    fmt.Sprintf("Volume %s is mounted by %d replicas", volume.Source, *service.Deploy.Replicas),
+					Context:    "All replicas write to the same volume, which needs ReadWriteMany storage and can corrupt data that is not written with concurrent access in mind.",
+					Suggestion: "Run a single replica, mount the volume read_only, or move the data to a database or object storage.",
+				})
+			}
+		}
+		return issues
+	},
+}
+
+var checkVolumeSize = Check{
+	Code:        "PW015",
+	Name:        "Missing Volume Size",
+	Description: "Check for named volumes without a size in driver_opts",
+	Severity:    SeverityInfo,
+	Category:    "storage",
+	Run: func(ctx *Context) []Issue {
+		var issues []Issue
+		for name, volume := range ctx.Project.Volumes {
+			if bool(volume.External) || volume.DriverOpts["size"] != "" {
+				continue
+			}
+			issues = append(issues, Issue{
+				Field:      "volumes." + name,
+				Message:    fmt.Sprintf("Volume %s has no size", name),
+				Context:    "Portway provisions volumes without a size with the default size of the region.",
+				Suggestion: "Set driver_opts.size, e.g. size: 10Gi, to request the storage the volume needs.",
+			})
+		}
+		return issues
+	},
+}
+
+func init() {
+	Register(checkBindMounts)
+	Register(checkDockerSocket)
+	Register(checkSharedVolumes)
+	Register(checkTmpfs)
+	Register(checkReplicatedVolumes)
+	Register(checkVolumeSize)
+}
@@ -1,7 +1,8 @@
 package lint
 
 import (
-	"cli/pkg/api"
+	"cli/pkg/compose"
+	"cli/pkg/secrets"
 	"fmt"
 	"strings"
 
@@ -9,75 +10,172 @@ import (
 	"github.com/pterm/pterm"
 )
 
-type ValidationResult struct {
-	Errors   []api.LintingIssue
-	Warnings []api.LintingIssue
-	Info     []api.LintingIssue
-}
-
-func DisplayValidationResults(issues []api.LintingIssue) {
-	totalIssues := len(issues)
+// DocsURL documents the checks
+const DocsURL = "https://docs.portway.dev/validate"
 
-	if totalIssues == 0 {
+// Display prints issues grouped by severity, followed by a summary
+func Display(issues []Issue) {
+	if len(issues) == 0 {
 		return
 	}
 
-	result := ValidationResult{
-		Errors:   []api.LintingIssue{},
-		Warnings: []api.LintingIssue{},
-		Info:     []api.LintingIssue{},
-	}
-
+	var errors, warnings, info []Issue
 	for _, issue := range issues {
-		switch strings.ToLower(string(issue.Severity)) {
-		case "error":
-			result.Errors = append(result.Errors, issue)
-		case "warning":
-			result.Warnings = append(result.Warnings, issue)
-		case "info":
-			result.Info = append(result.Info, issue)
+		switch issue.Severity {
+		case SeverityError:
+			errors = append(errors, issue)
+		case SeverityWarning:
+			warnings = append(warnings, issue)
+		default:
+			info = append(info, issue)
 		}
 	}
 
-	if len(result.Errors) > 0 {
-		fmt.Println()
-		fmt.Printf("%s  Critical Issues (%d):\n", color.RedString("❌"), len(result.Errors))
-		fmt.Println()
-		for i, issue := range result.Errors {
-			DisplayIssue(i+1, issue, "ERROR")
+	fmt.Println()
+	pterm.Printf("📊 Validation Summary: %d issue(s) found\n\n", len(issues))
+
+	if len(errors) > 0 {
+		pterm.Printf("%s  Critical Issues (%d):\n\n", pterm.Red("❌"), len(errors))
+		for i, issue := range errors {
+			DisplayIssue(i+1, issue)
 		}
-		fmt.Println()
 	}
 
-	if len(result.Warnings) > 0 {
-		fmt.Println()
-		fmt.Printf("%s  Warning Issues (%d):\n", color.YellowString("⚠️"), len(result.Warnings))
-		fmt.Println()
-		for i, issue := range result.Warnings {
-			DisplayIssue(i+1, issue, "WARNING")
+	if len(warnings) > 0 {
+		pterm.Printf("%s  Warnings (%d):\n\n", pterm.Yellow("⚠️"), len(warnings))
+		for i, issue := range warnings {
+			DisplayIssue(i+1, issue)
 		}
-		fmt.Println()
 	}
 
-	if len(result.Info) > 0 {
-		fmt.Println()
-		fmt.Printf("%s  Info Issues (%d):\n", color.BlackString("ℹ️"), len(result.Info))
-		fmt.Println()
-		for i, issue := range result.Info {
-			DisplayIssue(i+1, issue, "INFO")
+	if len(info) > 0 {
+		pterm.Printf("%s  Information (%d):\n\n", pterm.Blue("ℹ️"), len(info))
+		for i, issue := range info {
+			DisplayIssue(i+1, issue)
 		}
-		pterm.Println()
 	}
+
+	if len(errors) > 0 {
+		pterm.Printf("%s Fix critical issues before deploying to Kubernetes.\n", pterm.Red("🚨"))
+	} else if len(warnings) > 0 {
+		pterm.Printf("%s Warnings found, but deployment should work. Review suggestions for better reliability.\n", pterm.Yellow("💡"))
+	} else {
+		pterm.Printf("%s Only informational messages found. Your compose file is ready for deployment.\n", pterm.Green("✅"))
+	}
+
+	pterm.Printf("\n%s For more information about these checks, visit: %s\n\n", pterm.Gray("📚"), DocsURL)
 }
 
-func DisplayIssue(index int, issue api.LintingIssue, severityType string) {
-	fmt.Printf("   %d. %s %s\n", index, color.CyanString(issue.Code), color.New(color.Bold).Sprint(issue.Scope))
+// DisplayIssue prints a single numbered issue
+func DisplayIssue(index int, issue Issue) {
+	field := issue.Field
+	if field == "" {
+		field = issue.Service
+	}
+
+	fmt.Printf("   %d. [%s] %s %s\n", index, SeverityLabel(issue.Severity), color.CyanString(issue.Code), color.New(color.Bold).Sprint(field))
+	if !issue.Position.IsZero() {
+		fmt.Printf("      %s\n", color.New(color.Faint).Sprint(displayPath(issue.Position)))
+	}
 	fmt.Printf("      %s\n", issue.Message)
-	if issue.Context != nil {
-		fmt.Printf("      %s\n", color.New(color.Faint).Sprint(*issue.Context))
+	printCodeFrame(issue.Position)
+	if issue.Context != "" {
+		fmt.Printf("      %s\n", color.New(color.Faint).Sprint(issue.Context))
+	}
+	if issue.Suggestion != "" {
+		fmt.Printf("      %s %s\n", color.GreenString("💡"), color.New(color.Faint).Sprint(issue.Suggestion))
+	}
+	if issue.DocURL != "" {
+		fmt.Printf("      %s %s\n", color.BlueString("📚"), color.New(color.Faint).Sprint(issue.DocURL))
+	}
+	fmt.Println()
+}
+
+// codeFrameContext is the number of lines shown around an issue
+const codeFrameContext = 2
+
+// printCodeFrame prints the lines around pos with a marker under the column
+func printCodeFrame(pos compose.Position) {
+	if pos.IsZero() {
+		return
+	}
+
+	lines, err := compose.Excerpt(pos, codeFrameContext)
+	if err != nil {
+		return
 	}
-	if issue.DocUrl != "" {
-		fmt.Printf("      %s %s\n", color.GreenString("💡"), color.New(color.Faint).Sprint(issue.DocUrl))
+
+	width := len(fmt.Sprint(lines[len(lines)-1].Number))
+	faint := color.New(color.Faint)
+	for _, line := range lines {
+		line.Text = maskCredentials(line.Text)
+		gutter := fmt.Sprintf("%*d |", width, line.Number)
+		if line.Number != pos.Line {
+			fmt.Printf("        %s %s\n", faint.Sprint(gutter), faint.Sprint(line.Text))
+			continue
+		}
+
+		fmt.Printf("      %s %s %s\n", color.RedString(">"), gutter, line.Text)
+		marker := strings.Repeat(" ", width) + " | " + markerIndent(line.Text, pos.Column)
+		fmt.Printf("        %s%s\n", faint.Sprint(marker), color.RedString("^"))
+	}
+}
+
+// maskCredentials hides a value that looks like a credential in a line of
+// YAML, so code frames do not print secrets to terminals and CI logs
+func maskCredentials(text string) string {
+	content := strings.TrimLeft(text, " \t-")
+	indent := text[:len(text)-len(content)]
+
+	key, value, ok := strings.Cut(content, ":")
+	if ok && strings.HasPrefix(value, " ") {
+		key += ":"
+	} else {
+		key, value = "", content
+	}
+
+	trimmed := strings.Trim(strings.TrimSpace(value), `"'`)
+	name := strings.Trim(strings.TrimSuffix(key, ":"), `"' `)
+	if _, found := secrets.DetectCredential(name, trimmed); !found {
+		return text
+	}
+	if key != "" {
+		key += " "
+	}
+	return indent + key + secrets.Mask(trimmed)
+}
+
+// markerIndent returns the whitespace before column, keeping tabs so the
+// marker lines up with the source line
+func markerIndent(text string, column int) string {
+	var indent strings.Builder
+	for i, r := range []rune(text) {
+		if i >= column-1 {
+			break
+		}
+		if r == '\t' {
+			indent.WriteRune('\t')
+		} else {
+			indent.WriteRune(' ')
+		}
+	}
+	return indent.String()
+}
+
+// displayPath formats pos with the file relative to the working directory
+func displayPath(pos compose.Position) string {
+	return fmt.Sprintf("%s:%d:%d", relativePath(pos.File), pos.Line, pos.Column)
+}
+
+// SeverityLabel returns the colored, upper-case name of a severity
+func SeverityLabel(severity Severity) string {
+	label := strings.ToUpper(string(severity))
+	switch severity {
+	case SeverityError:
+		return pterm.Red(label)
+	case SeverityInfo:
+		return pterm.Blue(label)
+	default:
+		return pterm.Yellow(label)
 	}
-	pterm.Println()
 }
@@ -0,0 +1,159 @@
+package lint
+
+import (
+	"cli/pkg/yamledit"
+	"fmt"
+	"os"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"gopkg.in/yaml.v3"
+)
+
+// FixContext is what a fixer edits. Node is the mapping of the service in
+// the compose file the issue was located in.
+type FixContext struct {
+	Issue   Issue
+	Service types.ServiceConfig
+	Node    *yaml.Node
+}
+
+// FixedFile is a compose file rewritten by Fix
+type FixedFile struct {
+	Path     string
+	Original []byte
+	Fixed    []byte
+	Issues   []Issue
+}
+
+// Fix applies the fixers of the checks that reported issues to the compose
+// files, in the order they are merged. Comments and key order are kept.
+// Files are not written; only the files that changed are returned.
+func Fix(project *types.Project, files []string, issues []Issue, registry *Registry) ([]FixedFile, error) {
+	if registry == nil {
+		registry = DefaultRegistry
+	}
+
+	docs := make([]*fixDocument, 0, len(files))
+	for _, file := range files {
+		doc, err := parseFixDocument(file)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	for _, issue := range issues {
+		check, ok := registry.Get(issue.Code)
+		if !ok || check.Fix == nil || issue.Source != SourceLocal || issue.Service == "" {
+			continue
+		}
+
+		service, ok := project.Services[issue.Service]
+		if !ok {
+			continue
+		}
+
+		doc, node := serviceNode(docs, issue)
+		if node == nil {
+			continue
+		}
+
+		if check.Fix(&FixContext{Issue: issue, Service: service, Node: node}) {
+			doc.issues = append(doc.issues, issue)
+		}
+	}
+
+	var fixed []FixedFile
+	for _, doc := range docs {
+		if len(doc.issues) == 0 {
+			continue
+		}
+
+		data, err := doc.encode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to write %s: %w", doc.path, err)
+		}
+		fixed = append(fixed, FixedFile{Path: doc.path, Original: doc.data, Fixed: data, Issues: doc.issues})
+	}
+	return fixed, nil
+}
+
+// Fixable reports whether the check that reported issue has a fixer
+func Fixable(issue Issue, registry *Registry) bool {
+	if registry == nil {
+		registry = DefaultRegistry
+	}
+	check, ok := registry.Get(issue.Code)
+	return ok && check.Fix != nil && issue.Source == SourceLocal
+}
+
+type fixDocument struct {
+	path   string
+	data   []byte
+	root   yaml.Node
+	issues []Issue
+}
+
+func parseFixDocument(path string) (*fixDocument, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	doc := &fixDocument{path: path, data: data}
+	if err := yaml.Unmarshal(data, &doc.root); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return doc, nil
+}
+
+func (d *fixDocument) service(name string) *yaml.Node {
+	if len(d.root.Content) == 0 {
+		return nil
+	}
+	services := yamledit.MappingValue(d.root.Content[0], "services")
+	if services == nil {
+		return nil
+	}
+	service := yamledit.MappingValue(services, name)
+	if service == nil || service.Kind != yaml.MappingNode {
+		return nil
+	}
+	return service
+}
+
+func (d *fixDocument) encode() ([]byte, error) {
+	return yamledit.Encode(&d.root, d.data)
+}
+
+// serviceNode finds the service in the file the issue points at, or else in
+// the last file that defines it
+func serviceNode(docs []*fixDocument, issue Issue) (*fixDocument, *yaml.Node) {
+	for _, doc := range docs {
+		if doc.path == issue.Position.File {
+			if node := doc.service(issue.Service); node != nil {
+				return doc, node
+			}
+		}
+	}
+	for i := len(docs) - 1; i >= 0; i-- {
+		if node := docs[i].service(issue.Service); node != nil {
+			return docs[i], node
+		}
+	}
+	return nil, nil
+}
+
+// ensureMapping returns the mapping at key, creating it when missing
+func ensureMapping(node *yaml.Node, key string) *yaml.Node {
+	if value := yamledit.MappingValue(node, key); value != nil && value.Kind == yaml.MappingNode {
+		return value
+	}
+	value := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	yamledit.SetKey(node, key, value)
+	return value
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
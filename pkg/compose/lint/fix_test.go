@@ -0,0 +1,284 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+func TestFix(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   Check
+		service types.ServiceConfig
+		input   string
+		want    string
+	}{
+		{
+			name:    "privileged",
+			check:   checkPrivilegedMode,
+			service: types.ServiceConfig{Name: "web"},
+			input: `# Production stack
+services:
+    # Public web server
+    web:
+        image: nginx:1.27 # pinned
+        privileged: true
+
+        environment:
+            LOG_LEVEL: info
+
+    db:
+        image: postgres:16
+`,
+			want: `# Production stack
+services:
+    # Public web server
+    web:
+        image: nginx:1.27 # pinned
+
+        environment:
+            LOG_LEVEL: info
+
+    db:
+        image: postgres:16
+`,
+		},
+		{
+			name:    "dangerous capabilities",
+			check:   checkCapabilities,
+			service: types.ServiceConfig{Name: "web"},
+			input: `services:
+  web:
+    image: nginx:1.27
+    # Needed to bind port 80
+    cap_add:
+      - NET_BIND_SERVICE
+      - SYS_ADMIN
+
+    restart: always
+`,
+			want: `services:
+  web:
+    image: nginx:1.27
+    # Needed to bind port 80
+    cap_add:
+      - NET_BIND_SERVICE
+
+    restart: always
+`,
+		},
+		{
+			name:    "only dangerous capabilities",
+			check:   checkCapabilities,
+			service: types.ServiceConfig{Name: "web"},
+			input: `services:
+  web:
+    image: nginx:1.27
+    cap_add:
+      - ALL
+
+    restart: always
+`,
+			want: `services:
+  web:
+    image: nginx:1.27
+
+    restart: always
+`,
+		},
+		{
+			name:  "published internal port",
+			check: checkInternalPorts,
+			service: types.ServiceConfig{Name: "db", Ports: []types.ServicePortConfig{
+				{Target: 5432, Published: "5432", Protocol: "tcp"},
+				{Target: 5433, Protocol: "udp"},
+			}},
+			input: `services:
+    web:
+        image: nginx:1.27
+
+    # Primary database
+    db:
+        image: postgres:16
+        ports:
+            - "5432:5432"
+            - "5433/udp"
+        expose:
+            - "5432"
+        volumes:
+            - data:/var/lib/postgresql/data
+
+volumes:
+    data: {}
+`,
+			want: `services:
+    web:
+        image: nginx:1.27
+
+    # Primary database
+    db:
+        image: postgres:16
+        expose:
+            - "5432"
+            - "5433/udp"
+        volumes:
+            - data:/var/lib/postgresql/data
+
+volumes:
+    data: {}
+`,
+		},
+		{
+			name:    "missing resource limits",
+			check:   checkResourceLimits,
+			service: types.ServiceConfig{Name: "web"},
+			input: `services:
+  web:
+    image: nginx:1.27
+    deploy:
+      # Keep two copies up
+      replicas: 2
+      resources:
+        limits:
+          memory: 256M
+
+  worker:
+    image: worker:1.0
+`,
+			want: `services:
+  web:
+    image: nginx:1.27
+    deploy:
+      # Keep two copies up
+      replicas: 2
+      resources:
+        limits:
+          memory: 256M
+          cpus: "0.5"
+
+  worker:
+    image: worker:1.0
+`,
+		},
+		{
+			name:    "replicas with autoscaling",
+			check:   checkReplicasWithAutoscaling,
+			service: types.ServiceConfig{Name: "web"},
+			input: `services:
+  web:
+    image: nginx:1.27
+    deploy:
+      replicas: 3
+      restart_policy:
+        condition: on-failure
+
+    x-portway:
+      autoscaling:
+        min-replicas: 2
+        max-replicas: 6
+`,
+			want: `services:
+  web:
+    image: nginx:1.27
+    deploy:
+      restart_policy:
+        condition: on-failure
+
+    x-portway:
+      autoscaling:
+        min-replicas: 2
+        max-replicas: 6
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "compose.yaml")
+			if err := os.WriteFile(path, []byte(tt.input), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			registry := NewRegistry()
+			registry.Register(tt.check)
+			project := &types.Project{Services: types.Services{tt.service.Name: tt.service}}
+			issues := []Issue{{Code: tt.check.Code, Service: tt.service.Name, Source: SourceLocal}}
+
+			fixed, err := Fix(project, []string{path}, issues, registry)
+			if err != nil {
+				t.Fatalf("Fix: %v", err)
+			}
+			if len(fixed) != 1 {
+				t.Fatalf("Fix returned %d files, want 1", len(fixed))
+			}
+			if got := string(fixed[0].Fixed); got != tt.want {
+				t.Errorf("Fixed =\n%s\nwant\n%s", got, tt.want)
+			}
+			if got := string(fixed[0].Original); got != tt.input {
+				t.Errorf("Original =\n%s\nwant the file as read", got)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.input {
+				t.Error("Fix wrote the compose file")
+			}
+		})
+	}
+}
+
+func TestFixUnchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "compose.yaml")
+	if err := os.WriteFile(path, []byte("services:\n  web:\n    image: nginx:1.27\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewRegistry()
+	registry.Register(checkPrivilegedMode)
+	project := &types.Project{Services: types.Services{"web": {Name: "web"}}}
+	issues := []Issue{
+		{Code: checkPrivilegedMode.Code, Service: "web", Source: SourceLocal},
+		{Code: checkPrivilegedMode.Code, Service: "missing", Source: SourceLocal},
+	}
+
+	fixed, err := Fix(project, []string{path}, issues, registry)
+	if err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if len(fixed) != 0 {
+		t.Errorf("Fix returned %d files, want none when nothing changed", len(fixed))
+	}
+}
+
+func TestFixOverride(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "compose.yaml")
+	override := filepath.Join(dir, "compose.override.yaml")
+	if err := os.WriteFile(base, []byte("services:\n  web:\n    image: nginx:1.27\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(override, []byte("services:\n  web:\n    privileged: true\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewRegistry()
+	registry.Register(checkPrivilegedMode)
+	project := &types.Project{Services: types.Services{"web": {Name: "web"}}}
+	issues := []Issue{{Code: checkPrivilegedMode.Code, Service: "web", Source: SourceLocal}}
+
+	fixed, err := Fix(project, []string{base, override}, issues, registry)
+	if err != nil {
+		t.Fatalf("Fix: %v", err)
+	}
+	if len(fixed) != 1 || fixed[0].Path != override {
+		t.Fatalf("Fix returned %+v, want only %s", fixed, override)
+	}
+	if got, want := string(fixed[0].Fixed), "services:\n  web: {}\n"; got != want {
+		t.Errorf("Fixed = %q, want %q", got, want)
+	}
+}
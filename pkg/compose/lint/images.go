@@ -0,0 +1,66 @@
+package lint
+
+import (
+	"cli/pkg/registry"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// ImageInspector looks up images in their registry. *registry.Client
+// implements it; tests can use a fake registry or a stub.
+type ImageInspector interface {
+	Inspect(ctx context.Context, image string) (*registry.Image, error)
+}
+
+// ImageLookup is the result of looking up one image
+type ImageLookup struct {
+	Image *registry.Image
+	Err   error
+}
+
+// maxImageLookups is the number of images looked up at once
+const maxImageLookups = 4
+
+// imageLookupTimeout bounds all the lookups of a run, so a slow or rate
+// limited registry does not hold up validate or deploy. Images not looked
+// up in time are not checked.
+const imageLookupTimeout = 30 * time.Second
+
+// inspectImages looks up the image of every service, keyed by image
+func inspectImages(ctx context.Context, project *types.Project, inspector ImageInspector) map[string]ImageLookup {
+	ctx, cancel := context.WithTimeout(ctx, imageLookupTimeout)
+	defer cancel()
+
+	images := map[string]bool{}
+	for _, service := range project.Services {
+		if service.Image != "" {
+			images[service.Image] = true
+		}
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		lookups = make(map[string]ImageLookup, len(images))
+		limit   = make(chan struct{}, maxImageLookups)
+	)
+	for image := range images {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			result, err := inspector.Inspect(ctx, image)
+			mu.Lock()
+			lookups[image] = ImageLookup{Image: result, Err: err}
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return lookups
+}
@@ -0,0 +1,142 @@
+package lint
+
+import (
+	"cli/pkg/api"
+	"cli/pkg/compose"
+	"sort"
+	"strings"
+)
+
+// Severity of an issue. Errors block deploys.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// ParseSeverity normalizes a severity from the API or a config file
+func ParseSeverity(value string) Severity {
+	return Severity(strings.ToLower(strings.TrimSpace(value)))
+}
+
+// Valid reports whether the severity is one of the known levels
+func (s Severity) Valid() bool {
+	return s == SeverityError || s == SeverityWarning || s == SeverityInfo
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityError:
+		return 0
+	case SeverityWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Source says which linter reported an issue
+type Source string
+
+const (
+	SourceLocal  Source = "local"
+	SourceRemote Source = "remote"
+)
+
+// Issue is a problem found in a compose project, by a local check or by the
+// Portway API
+type Issue struct {
+	Code       string
+	Severity   Severity
+	Category   string
+	Service    string
+	Field      string
+	Message    string
+	Context    string
+	Suggestion string
+	DocURL     string
+	Source     Source
+
+	// Position of Field in the compose files, when known
+	Position compose.Position
+}
+
+// Locate sets the position of every issue that has none from the compose
+// source map
+func Locate(issues []Issue, sources *compose.SourceMap) {
+	for i := range issues {
+		if !issues[i].Position.IsZero() {
+			continue
+		}
+		path := issues[i].Field
+		if path == "" && issues[i].Service != "" {
+			path = "services." + issues[i].Service
+		}
+		if position, ok := sources.Lookup(path); ok {
+			issues[i].Position = position
+		}
+	}
+}
+
+// fromAPI converts an issue reported by the lint endpoint
+func fromAPI(issue api.LintingIssue) Issue {
+	converted := Issue{
+		Code:     issue.Code,
+		Severity: ParseSeverity(string(issue.Severity)),
+		Field:    issue.Scope,
+		Message:  issue.Message,
+		DocURL:   issue.DocUrl,
+		Source:   SourceRemote,
+	}
+	if issue.Context != nil {
+		converted.Context = *issue.Context
+	}
+	if service, ok := strings.CutPrefix(issue.Scope, "services."); ok {
+		converted.Service, _, _ = strings.Cut(service, ".")
+	}
+	return converted
+}
+
+// HasErrors reports whether any issue has error severity
+func HasErrors(issues []Issue) bool {
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Merge combines local and remote issues. When both report the same code
+// for the same field, the local issue is kept.
+func Merge(local, remote []Issue) []Issue {
+	seen := make(map[string]bool, len(local))
+	merged := make([]Issue, 0, len(local)+len(remote))
+	for _, issue := range local {
+		seen[issue.Code+"\x00"+issue.Field] = true
+		merged = append(merged, issue)
+	}
+	for _, issue := range remote {
+		if !seen[issue.Code+"\x00"+issue.Field] {
+			merged = append(merged, issue)
+		}
+	}
+	Sort(merged)
+	return merged
+}
+
+// Sort orders issues by severity, code and field
+func Sort(issues []Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Severity.rank() != b.Severity.rank() {
+			return a.Severity.rank() < b.Severity.rank()
+		}
+		if a.Code != b.Code {
+			return a.Code < b.Code
+		}
+		return a.Field < b.Field
+	})
+}
@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit reports one test case per issue, and one passing test case per
+// check that found nothing. Info issues pass with their message as output.
+func writeJUnit(w io.Writer, report Report) error {
+	suite := junitTestSuite{Name: "portway validate"}
+
+	failed := map[string]bool{}
+	for _, issue := range report.Issues {
+		failed[issue.Code] = true
+
+		testCase := junitTestCase{
+			Name:      strings.TrimSpace(issue.Code + " " + issue.Field),
+			ClassName: "portway." + categoryOrDefault(issue.Category),
+			File:      relativePath(issue.Position.File),
+			Line:      issue.Position.Line,
+		}
+
+		text := issue.Message
+		if issue.Suggestion != "" {
+			text += "\n" + issue.Suggestion
+		}
+		if !issue.Position.IsZero() {
+			text += "\n" + fmt.Sprintf("%s:%d:%d", relativePath(issue.Position.File), issue.Position.Line, issue.Position.Column)
+		}
+
+		if issue.Severity == SeverityInfo {
+			testCase.SystemOut = text
+		} else {
+			testCase.Failure = &junitFailure{Message: issue.Message, Type: string(issue.Severity), Text: text}
+			suite.Failures++
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	for _, check := range report.Checks {
+		if failed[check.Code] {
+			continue
+		}
+		suite.TestCases = append(suite.TestCases, junitTestCase{
+			Name:      check.Code + " " + check.Name,
+			ClassName: "portway." + categoryOrDefault(check.Category),
+		})
+	}
+	suite.Tests = len(suite.TestCases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{
+		Name:     "portway",
+		Tests:    suite.Tests,
+		Failures: suite.Failures,
+		Suites:   []junitTestSuite{suite},
+	}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func categoryOrDefault(category string) string {
+	if category == "" {
+		return "remote"
+	}
+	return category
+}
@@ -2,16 +2,113 @@ package lint
 
 import (
 	"cli/pkg/api"
+	"cli/pkg/compose"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/charmbracelet/huh"
 	"github.com/compose-spec/compose-go/v2/types"
 )
 
-func Lint(client *api.ClientWithResponses, project *types.Project) ([]api.LintingIssue, error) {
+// Options controls which checks run
+type Options struct {
+	// Skip lists check codes that are not run or reported
+	Skip []string
+
+	// Client runs the remote checks of the Portway API. When nil, only the
+	// local checks run.
+	Client *api.ClientWithResponses
+
+	// Registry holds the local checks, DefaultRegistry when nil
+	Registry *Registry
+
+	// Policy overrides severities and ignores issues, from the lint section
+	// of .portway.yaml. x-portway-ignore is respected without a policy.
+	Policy *Policy
+
+	// Plan holds the limits of the organization plan. When nil, the plan
+	// checks do not run.
+	Plan *api.PlanLimits
+
+	// Images looks up the service images in their registries. When nil,
+	// the checks that need the registry do not run.
+	Images ImageInspector
+
+	// Sources locates issues in the compose files. When nil, issues have
+	// no position.
+	Sources *compose.SourceMap
+}
+
+// Run lints a project with the local checks and, when a client is set,
+// merges in the results of the Portway API
+func Run(ctx context.Context, project *types.Project, opts Options) ([]Issue, error) {
+	issues := RunLocal(ctx, project, opts)
+
+	if opts.Client == nil {
+		return issues, nil
+	}
+
+	remote, err := Remote(ctx, opts.Client, project)
+	if err != nil {
+		return issues, err
+	}
+
+	remote = opts.Policy.Apply(skip(remote, opts.skipped()), project)
+	Locate(remote, opts.Sources)
+	return Merge(issues, remote), nil
+}
+
+// RunLocal runs the local checks. It needs no network access unless
+// opts.Images is set.
+func RunLocal(ctx context.Context, project *types.Project, opts Options) []Issue {
+	registry := opts.Registry
+	if registry == nil {
+		registry = DefaultRegistry
+	}
+
+	skipped := skipSet(opts.skipped())
+	checkCtx := &Context{Project: project, Policy: opts.Policy, Sources: opts.Sources, Plan: opts.Plan}
+	if opts.Images != nil {
+		checkCtx.Images = inspectImages(ctx, project, opts.Images)
+	}
+
+	var issues []Issue
+	for _, check := range registry.All() {
+		if skipped[check.Code] {
+			continue
+		}
+
+		for _, issue := range check.Run(checkCtx) {
+			issue.Code = check.Code
+			issue.Severity = check.Severity
+			issue.Category = check.Category
+			if issue.DocURL == "" {
+				issue.DocURL = check.DocURL
+			}
+			issue.Source = SourceLocal
+			issues = append(issues, issue)
+		}
+	}
+
+	issues = opts.Policy.Apply(issues, project)
+	Locate(issues, opts.Sources)
+	Sort(issues)
+	return issues
+}
+
+// skipped returns the codes skipped on the command line or turned off by
+// the policy
+func (o Options) skipped() []string {
+	return append(slices.Clone(o.Skip), o.Policy.Disabled()...)
+}
+
+// Remote runs the checks of the Portway API
+func Remote(ctx context.Context, client *api.ClientWithResponses, project *types.Project) ([]Issue, error) {
 	var result map[string]interface{}
 	data, err := json.Marshal(project)
 	if err != nil {
@@ -21,18 +118,77 @@ func Lint(client *api.ClientWithResponses, project *types.Project) ([]api.Lintin
 		return nil, err
 	}
 
-	response, err := client.LintComposeFileObjectWithResponse(
-		context.Background(), result)
+	response, err := client.LintComposeFileObjectWithResponse(ctx, result)
 	if err != nil {
 		return nil, err
 	}
 	if response.JSON200 == nil {
 		return nil, fmt.Errorf("failed to lint compose file")
 	}
-	return response.JSON200.Results, nil
+
+	issues := make([]Issue, 0, len(response.JSON200.Results))
+	for _, issue := range response.JSON200.Results {
+		issues = append(issues, fromAPI(issue))
+	}
+	return issues, nil
 }
 
+// FetchPlan returns the plan limits of an organization, or nil when the API
+// does not report one. When orgSlug is empty, the organization of the API
+// key is used.
+func FetchPlan(ctx context.Context, client *api.ClientWithResponses, orgSlug string) (*api.PlanLimits, error) {
+	if orgSlug == "" {
+		whoami, err := client.GetApiV1WhoamiWithResponse(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if whoami.JSON200 == nil || whoami.JSON200.Organization == nil {
+			return nil, fmt.Errorf("failed to get organization: %d", whoami.StatusCode())
+		}
+		orgSlug = whoami.JSON200.Organization.Slug
+	}
+
+	response, err := client.GetOrganizationPlanWithResponse(ctx, orgSlug)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() == 404 {
+		return nil, nil
+	}
+	if response.JSON200 == nil {
+		return nil, fmt.Errorf("failed to get plan limits: %d", response.StatusCode())
+	}
+	return response.JSON200, nil
+}
+
+func skipSet(codes []string) map[string]bool {
+	skipped := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		skipped[strings.ToUpper(strings.TrimSpace(code))] = true
+	}
+	return skipped
+}
+
+func skip(issues []Issue, codes []string) []Issue {
+	skipped := skipSet(codes)
+	kept := issues[:0]
+	for _, issue := range issues {
+		if !skipped[issue.Code] {
+			kept = append(kept, issue)
+		}
+	}
+	return kept
+}
+
+// ConfigLintMessages asks whether to deploy despite the issues. Without a
+// terminal to ask on, such as in CI, the deploy continues; lint.fail-on
+// blocks issues there.
 func ConfigLintMessages() error {
+	if info, err := os.Stdin.Stat(); err != nil || info.Mode()&os.ModeCharDevice == 0 {
+		fmt.Print("\nContinuing despite linting issues, as there is no terminal to confirm. Set lint.fail-on to block them.\n\n")
+		return nil
+	}
+
 	confirmed := true
 
 	form := huh.NewForm(
@@ -49,8 +205,7 @@ func ConfigLintMessages() error {
 	}
 
 	if !confirmed {
-		fmt.Print("\nAborted due to linting issues.\n\n")
-		os.Exit(1)
+		return errors.New("aborted due to linting issues")
 	}
 
 	return nil
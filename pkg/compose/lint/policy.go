@@ -0,0 +1,203 @@
+package lint
+
+import (
+	"cli/pkg/compose"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	units "github.com/docker/go-units"
+)
+
+// SeverityOff disables a check in a policy severity override
+const SeverityOff = "off"
+
+// Policy is the lint section of .portway.yaml. It applies to validate and
+// deploy alike.
+type Policy struct {
+	// Severity overrides the severity of checks by code, or turns them off
+	Severity map[string]string `yaml:"severity,omitempty"`
+
+	// Ignore lists the check codes ignored per service. The "*" service
+	// applies to every service.
+	Ignore map[string][]string `yaml:"ignore,omitempty"`
+
+	// FailOn is the lowest severity that fails validate and deploy, error
+	// when empty
+	FailOn Severity `yaml:"fail-on,omitempty"`
+
+	// MaxImageSize is the largest compressed image size accepted, e.g. 1GiB
+	MaxImageSize string `yaml:"max-image-size,omitempty"`
+
+	// MaxContextSize is the largest build context accepted, e.g. 200MiB
+	MaxContextSize string `yaml:"max-context-size,omitempty"`
+
+	// Rules lists files of custom rules, relative to .portway.yaml
+	Rules []string `yaml:"rules,omitempty"`
+}
+
+// DefaultMaxImageSize is the largest image size accepted without a policy
+const DefaultMaxImageSize = 2 << 30
+
+// ImageSizeLimit returns the largest accepted image size in bytes
+func (p *Policy) ImageSizeLimit() (int64, error) {
+	if p == nil || p.MaxImageSize == "" {
+		return DefaultMaxImageSize, nil
+	}
+	size, err := units.RAMInBytes(p.MaxImageSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lint max-image-size %q: %w", p.MaxImageSize, err)
+	}
+	return size, nil
+}
+
+// DefaultMaxContextSize is the largest build context accepted without a
+// policy
+const DefaultMaxContextSize = 500 << 20
+
+// ContextSizeLimit returns the largest accepted build context in bytes
+func (p *Policy) ContextSizeLimit() (int64, error) {
+	if p == nil || p.MaxContextSize == "" {
+		return DefaultMaxContextSize, nil
+	}
+	size, err := units.RAMInBytes(p.MaxContextSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lint max-context-size %q: %w", p.MaxContextSize, err)
+	}
+	return size, nil
+}
+
+// Validate reports unknown severities in the policy
+func (p *Policy) Validate() error {
+	if p == nil {
+		return nil
+	}
+
+	for code, severity := range p.Severity {
+		if severity != SeverityOff && !ParseSeverity(severity).Valid() {
+			return fmt.Errorf("invalid lint severity %q for %s, expected error, warning, info or off", severity, code)
+		}
+	}
+
+	if p.FailOn != "" && !ParseSeverity(string(p.FailOn)).Valid() {
+		return fmt.Errorf("invalid lint fail-on %q, expected error, warning or info", p.FailOn)
+	}
+
+	if _, err := p.ImageSizeLimit(); err != nil {
+		return err
+	}
+	_, err := p.ContextSizeLimit()
+	return err
+}
+
+// Disabled returns the codes of the checks turned off
+func (p *Policy) Disabled() []string {
+	if p == nil {
+		return nil
+	}
+
+	var codes []string
+	for code, severity := range p.Severity {
+		if strings.EqualFold(severity, SeverityOff) {
+			codes = append(codes, strings.ToUpper(code))
+		}
+	}
+	return codes
+}
+
+// Apply overrides severities and drops the issues ignored by the policy or
+// by x-portway-ignore on their service
+func (p *Policy) Apply(issues []Issue, project *types.Project) []Issue {
+	kept := issues[:0]
+	for _, issue := range issues {
+		if p.ignored(issue) || ignoredInline(issue, project) {
+			continue
+		}
+		if severity, ok := p.severity(issue.Code); ok {
+			issue.Severity = severity
+		}
+		kept = append(kept, issue)
+	}
+	return kept
+}
+
+// Failing returns the issues at or above the fail-on severity
+func (p *Policy) Failing(issues []Issue) []Issue {
+	failOn := SeverityError
+	if p != nil && p.FailOn != "" {
+		failOn = ParseSeverity(string(p.FailOn))
+	}
+
+	var failing []Issue
+	for _, issue := range issues {
+		if issue.Severity.rank() <= failOn.rank() {
+			failing = append(failing, issue)
+		}
+	}
+	return failing
+}
+
+// Unconfirmed returns the warnings and errors below the fail-on severity,
+// which deploy asks to confirm. Infos never need confirmation.
+func (p *Policy) Unconfirmed(issues []Issue) []Issue {
+	failing := p.Failing(issues)
+
+	var unconfirmed []Issue
+	for _, issue := range issues {
+		if issue.Severity.rank() <= SeverityWarning.rank() && !slices.Contains(failing, issue) {
+			unconfirmed = append(unconfirmed, issue)
+		}
+	}
+	return unconfirmed
+}
+
+// Fails reports whether any issue is at or above the fail-on severity
+func (p *Policy) Fails(issues []Issue) bool {
+	return len(p.Failing(issues)) > 0
+}
+
+func (p *Policy) severity(code string) (Severity, bool) {
+	if p == nil {
+		return "", false
+	}
+	for key, value := range p.Severity {
+		if strings.EqualFold(key, code) {
+			severity := ParseSeverity(value)
+			return severity, severity.Valid()
+		}
+	}
+	return "", false
+}
+
+func (p *Policy) ignored(issue Issue) bool {
+	if p == nil {
+		return false
+	}
+	for _, service := range []string{"*", issue.Service} {
+		if service == "" {
+			continue
+		}
+		if containsCode(p.Ignore[service], issue.Code) {
+			return true
+		}
+	}
+	return false
+}
+
+func ignoredInline(issue Issue, project *types.Project) bool {
+	if issue.Service == "" || project == nil {
+		return false
+	}
+	service, ok := project.Services[issue.Service]
+	if !ok {
+		return false
+	}
+	return containsCode(compose.IgnoredChecks(service), issue.Code)
+}
+
+func containsCode(codes []string, code string) bool {
+	return slices.ContainsFunc(codes, func(c string) bool {
+		return strings.EqualFold(strings.TrimSpace(c), code)
+	})
+}
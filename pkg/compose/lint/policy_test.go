@@ -0,0 +1,41 @@
+package lint
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnconfirmed(t *testing.T) {
+	issues := []Issue{
+		{Code: "PW001", Severity: SeverityError},
+		{Code: "PW033", Severity: SeverityWarning},
+		{Code: "PW041", Severity: SeverityInfo},
+	}
+
+	tests := []struct {
+		name   string
+		policy *Policy
+		want   []string
+	}{
+		{name: "default", policy: nil, want: []string{"PW033"}},
+		{name: "fail on warning", policy: &Policy{FailOn: SeverityWarning}, want: nil},
+		{name: "fail on info", policy: &Policy{FailOn: SeverityInfo}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, issue := range tt.policy.Unconfirmed(issues) {
+				got = append(got, issue.Code)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Unconfirmed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	infos := []Issue{{Code: "PW041", Severity: SeverityInfo}}
+	if got := (*Policy)(nil).Unconfirmed(infos); len(got) != 0 {
+		t.Errorf("Unconfirmed(infos) = %v, want none", got)
+	}
+}
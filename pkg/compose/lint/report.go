@@ -0,0 +1,158 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Report formats for validate --format
+const (
+	FormatText   = "text"
+	FormatJSON   = "json"
+	FormatSARIF  = "sarif"
+	FormatJUnit  = "junit"
+	FormatGitHub = "github"
+)
+
+// Formats lists the supported report formats
+var Formats = []string{FormatText, FormatJSON, FormatSARIF, FormatJUnit, FormatGitHub}
+
+// Report is the outcome of linting, written in a machine-readable format
+type Report struct {
+	// Files are the compose files that were linted
+	Files []string
+	// Checks are the local checks that ran
+	Checks []Check
+	Issues []Issue
+}
+
+// WriteReport writes the report in one of the machine-readable formats
+func WriteReport(w io.Writer, format string, report Report) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, report)
+	case FormatSARIF:
+		return writeSARIF(w, report)
+	case FormatJUnit:
+		return writeJUnit(w, report)
+	case FormatGitHub:
+		return writeGitHub(w, report)
+	default:
+		return fmt.Errorf("unsupported format %q, expected one of %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+type jsonIssue struct {
+	Code       string   `json:"code"`
+	Severity   Severity `json:"severity"`
+	Category   string   `json:"category,omitempty"`
+	Service    string   `json:"service,omitempty"`
+	Field      string   `json:"field,omitempty"`
+	Message    string   `json:"message"`
+	Context    string   `json:"context,omitempty"`
+	Suggestion string   `json:"suggestion,omitempty"`
+	DocURL     string   `json:"docUrl,omitempty"`
+	Source     Source   `json:"source"`
+	File       string   `json:"file,omitempty"`
+	Line       int      `json:"line,omitempty"`
+	Column     int      `json:"column,omitempty"`
+}
+
+func writeJSON(w io.Writer, report Report) error {
+	summary := map[Severity]int{SeverityError: 0, SeverityWarning: 0, SeverityInfo: 0}
+	issues := make([]jsonIssue, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		summary[issue.Severity]++
+		issues = append(issues, jsonIssue{
+			Code:       issue.Code,
+			Severity:   issue.Severity,
+			Category:   issue.Category,
+			Service:    issue.Service,
+			Field:      issue.Field,
+			Message:    issue.Message,
+			Context:    issue.Context,
+			Suggestion: issue.Suggestion,
+			DocURL:     issue.DocURL,
+			Source:     issue.Source,
+			File:       relativePath(issue.Position.File),
+			Line:       issue.Position.Line,
+			Column:     issue.Position.Column,
+		})
+	}
+
+	files := make([]string, 0, len(report.Files))
+	for _, file := range report.Files {
+		files = append(files, relativePath(file))
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(map[string]any{
+		"files":   files,
+		"issues":  issues,
+		"summary": summary,
+	})
+}
+
+func writeGitHub(w io.Writer, report Report) error {
+	for _, issue := range report.Issues {
+		command := "warning"
+		switch issue.Severity {
+		case SeverityError:
+			command = "error"
+		case SeverityInfo:
+			command = "notice"
+		}
+
+		var properties []string
+		if !issue.Position.IsZero() {
+			properties = append(properties,
+				"file="+escapeGitHubProperty(relativePath(issue.Position.File)),
+				fmt.Sprintf("line=%d", issue.Position.Line),
+				fmt.Sprintf("col=%d", issue.Position.Column),
+			)
+		}
+		properties = append(properties, "title="+escapeGitHubProperty(issue.Code+" "+issue.Field))
+
+		message := issue.Message
+		if issue.Suggestion != "" {
+			message += "\n" + issue.Suggestion
+		}
+
+		if _, err := fmt.Fprintf(w, "::%s %s::%s\n", command, strings.Join(properties, ","), escapeGitHubData(message)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escapeGitHubData escapes a workflow command message
+func escapeGitHubData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// escapeGitHubProperty escapes a workflow command property value
+func escapeGitHubProperty(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(value)
+}
+
+// relativePath returns path relative to the working directory when it is
+// inside it, so reports point at repository files
+func relativePath(path string) string {
+	if path == "" || !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
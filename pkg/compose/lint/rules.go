@@ -0,0 +1,311 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	units "github.com/docker/go-units"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule scopes
+const (
+	ScopeService = "service"
+	ScopeProject = "project"
+)
+
+// CustomCategory is the category of rules that do not set one
+const CustomCategory = "custom"
+
+// RuleFile is a file of custom rules referenced by the lint section of
+// .portway.yaml
+type RuleFile struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule is a custom check written in CEL over the compose project as it is
+// uploaded to Portway. Expression must be true for every service, or for the
+// project with scope project; an issue is reported where it is false or
+// fails.
+type Rule struct {
+	Code        string   `yaml:"code"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Severity    Severity `yaml:"severity"`
+	Category    string   `yaml:"category,omitempty"`
+	DocURL      string   `yaml:"doc-url,omitempty"`
+
+	// Scope is service or project, service when empty
+	Scope string `yaml:"scope,omitempty"`
+
+	// Expression sees service, the service as JSON, name, the service name,
+	// and project, the whole project as JSON
+	Expression string `yaml:"expression"`
+
+	// Message is reported when the expression is false. MessageExpression,
+	// when set, builds the message in CEL instead.
+	Message           string `yaml:"message"`
+	MessageExpression string `yaml:"message-expression,omitempty"`
+
+	// Field is the field the issue points at, relative to the service for
+	// service rules, e.g. labels or deploy.resources.limits
+	Field      string `yaml:"field,omitempty"`
+	Suggestion string `yaml:"suggestion,omitempty"`
+}
+
+// LoadRules returns a copy of base with the rules of the files added. Paths
+// are relative to dir.
+func LoadRules(base *Registry, paths []string, dir string) (*Registry, error) {
+	registry := base.Clone()
+	if len(paths) == 0 {
+		return registry, nil
+	}
+
+	env, err := ruleEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read lint rules: %w", err)
+		}
+
+		var file RuleFile
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&file); err != nil {
+			return nil, fmt.Errorf("failed to parse lint rules %s: %w", path, err)
+		}
+
+		for _, rule := range file.Rules {
+			rule.Code = strings.ToUpper(strings.TrimSpace(rule.Code))
+			if existing, ok := registry.Get(rule.Code); ok {
+				return nil, fmt.Errorf("lint rule %s in %s: code is already used by %s", rule.Code, path, existing.Name)
+			}
+
+			check, err := rule.compile(env)
+			if err != nil {
+				return nil, fmt.Errorf("lint rule %s in %s: %w", rule.Code, path, err)
+			}
+			registry.Register(check)
+		}
+	}
+
+	return registry, nil
+}
+
+// ruleEnv declares the variables and functions rules can use. parseBytes
+// converts sizes such as 2G to bytes, as memory limits are in bytes.
+func ruleEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("service", cel.DynType),
+		cel.Variable("name", cel.StringType),
+		cel.Variable("project", cel.DynType),
+		cel.OptionalTypes(),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+		cel.Function("parseBytes",
+			cel.Overload("parseBytes_string", []*cel.Type{cel.StringType}, cel.IntType,
+				cel.UnaryBinding(func(value ref.Val) ref.Val {
+					size, err := units.RAMInBytes(string(value.(types.String)))
+					if err != nil {
+						return types.NewErr("parseBytes: %v", err)
+					}
+					return types.Int(size)
+				}),
+			),
+		),
+	)
+}
+
+func (r Rule) compile(env *cel.Env) (Check, error) {
+	if r.Code == "" {
+		return Check{}, fmt.Errorf("code is required")
+	}
+	if !r.Severity.Valid() {
+		return Check{}, fmt.Errorf("invalid severity %q, expected error, warning or info", r.Severity)
+	}
+	if r.Message == "" && r.MessageExpression == "" {
+		return Check{}, fmt.Errorf("message is required")
+	}
+	if r.Scope == "" {
+		r.Scope = ScopeService
+	}
+	if r.Scope != ScopeService && r.Scope != ScopeProject {
+		return Check{}, fmt.Errorf("invalid scope %q, expected service or project", r.Scope)
+	}
+	if r.Category == "" {
+		r.Category = CustomCategory
+	}
+	if r.Name == "" {
+		r.Name = r.Code
+	}
+
+	program, err := compileExpression(env, r.Expression, cel.BoolType)
+	if err != nil {
+		return Check{}, fmt.Errorf("expression: %w", err)
+	}
+
+	var message cel.Program
+	if r.MessageExpression != "" {
+		message, err = compileExpression(env, r.MessageExpression, cel.StringType)
+		if err != nil {
+			return Check{}, fmt.Errorf("message-expression: %w", err)
+		}
+	}
+
+	return Check{
+		Code:        r.Code,
+		Name:        r.Name,
+		Description: r.Description,
+		Severity:    r.Severity,
+		Category:    r.Category,
+		DocURL:      r.DocURL,
+		Run: func(ctx *Context) []Issue {
+			return r.run(ctx, program, message)
+		},
+	}, nil
+}
+
+func compileExpression(env *cel.Env, expression string, output *cel.Type) (cel.Program, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, fmt.Errorf("is required")
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsEquivalentType(output) && !ast.OutputType().IsEquivalentType(cel.DynType) {
+		return nil, fmt.Errorf("must evaluate to %s, not %s", output, ast.OutputType())
+	}
+	return env.Program(ast)
+}
+
+func (r Rule) run(ctx *Context, program, message cel.Program) []Issue {
+	document, err := ctx.document()
+	if err != nil {
+		// A rule that cannot see the project must not pass
+		return []Issue{{
+			Field:   r.Field,
+			Message: r.Code + " could not be evaluated",
+			Context: "The project could not be converted for the rule expression: " + err.Error(),
+		}}
+	}
+
+	if r.Scope == ScopeProject {
+		vars := map[string]any{"project": document, "service": map[string]any{}, "name": ""}
+		if issue, failed := r.evaluate(program, message, vars); failed {
+			issue.Field = r.Field
+			return []Issue{issue}
+		}
+		return nil
+	}
+
+	services, _ := document["services"].(map[string]any)
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var issues []Issue
+	for _, name := range names {
+		vars := map[string]any{"project": document, "service": services[name], "name": name}
+		issue, failed := r.evaluate(program, message, vars)
+		if !failed {
+			continue
+		}
+		issue.Service = name
+		issue.Field = "services." + name
+		if r.Field != "" {
+			issue.Field += "." + r.Field
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// evaluate runs the expression and returns the issue when it is false or
+// fails, e.g. because a field it reads is missing
+func (r Rule) evaluate(program, message cel.Program, vars map[string]any) (Issue, bool) {
+	issue := Issue{Message: r.Message, Suggestion: r.Suggestion}
+
+	result, _, err := program.Eval(vars)
+	if err == nil {
+		if passed, ok := result.Value().(bool); ok && passed {
+			return Issue{}, false
+		}
+	} else {
+		issue.Context = "The rule expression failed: " + err.Error()
+	}
+
+	if message != nil {
+		if value, _, err := message.Eval(vars); err == nil {
+			if text, ok := value.Value().(string); ok {
+				issue.Message = text
+			}
+		}
+	}
+	if issue.Message == "" {
+		issue.Message = "Rule " + r.Name + " is not satisfied"
+	}
+	return issue, true
+}
+
+// document returns the project as uploaded to Portway, with integral
+// numbers as int64 so rules can compare them with int literals
+func (c *Context) document() (map[string]any, error) {
+	if c.normalized != nil {
+		return c.normalized, nil
+	}
+
+	data, err := json.Marshal(c.Project)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var document map[string]any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	c.normalized = convertNumbers(document).(map[string]any)
+	return c.normalized, nil
+}
+
+func convertNumbers(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		n, _ := v.Float64()
+		return n
+	}
+	return value
+}
@@ -0,0 +1,81 @@
+package lint
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+func loadTestRules(t *testing.T, rules string) *Registry {
+	t.Helper()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "rules.yaml"), []byte(rules), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := LoadRules(NewRegistry(), []string{"rules.yaml"}, dir)
+	if err != nil {
+		t.Fatalf("LoadRules: %v", err)
+	}
+	return registry
+}
+
+const teamLabelRule = `rules:
+  - code: acme001
+    name: Team Label
+    severity: error
+    expression: '"team" in service.?labels.orValue({})'
+    message: Service has no team label
+    field: labels
+  - code: ACME002
+    name: Few Services
+    severity: warning
+    scope: project
+    expression: size(project.services) <= 1
+    message: Too many services
+`
+
+func TestRules(t *testing.T) {
+	project := &types.Project{Services: types.Services{
+		"web":    {Name: "web", Image: "nginx", Labels: types.Labels{"team": "shop"}},
+		"worker": {Name: "worker", Image: "worker"},
+	}}
+
+	issues := RunLocal(context.Background(), project, Options{Registry: loadTestRules(t, teamLabelRule)})
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.Code+" "+issue.Field)
+	}
+	slices.Sort(got)
+
+	want := []string{"ACME001 services.worker.labels", "ACME002 "}
+	if !slices.Equal(got, want) {
+		t.Errorf("issues = %q, want %q", got, want)
+	}
+}
+
+func TestRulesFailWithoutProject(t *testing.T) {
+	// NaN cannot be converted to JSON, so no rule can see the project
+	limits := &types.Resource{NanoCPUs: types.NanoCPUs(math.NaN())}
+	project := &types.Project{Services: types.Services{
+		"web": {Name: "web", Image: "nginx", Labels: types.Labels{"team": "shop"}, Deploy: &types.DeployConfig{Resources: types.Resources{Limits: limits}}},
+	}}
+
+	issues := RunLocal(context.Background(), project, Options{Registry: loadTestRules(t, teamLabelRule)})
+
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.Code)
+	}
+	slices.Sort(got)
+
+	if want := []string{"ACME001", "ACME002"}; !slices.Equal(got, want) {
+		t.Errorf("issues = %q, want an issue for every rule", got)
+	}
+}
@@ -0,0 +1,148 @@
+package lint
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+const sarifSchema = "https://json.schemastore.org/sarif-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	Name                 string             `json:"name,omitempty"`
+	ShortDescription     *sarifMessage      `json:"shortDescription,omitempty"`
+	HelpURI              string             `json:"helpUri,omitempty"`
+	DefaultConfiguration *sarifRuleDefaults `json:"defaultConfiguration,omitempty"`
+	Properties           map[string]any     `json:"properties,omitempty"`
+}
+
+type sarifRuleDefaults struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+}
+
+func sarifLevel(severity Severity) string {
+	switch severity {
+	case SeverityError:
+		return "error"
+	case SeverityInfo:
+		return "note"
+	default:
+		return "warning"
+	}
+}
+
+func writeSARIF(w io.Writer, report Report) error {
+	rules := map[string]sarifRule{}
+	for _, check := range report.Checks {
+		rules[check.Code] = sarifRule{
+			ID:                   check.Code,
+			Name:                 check.Name,
+			ShortDescription:     &sarifMessage{Text: check.Description},
+			HelpURI:              check.DocURL,
+			DefaultConfiguration: &sarifRuleDefaults{Level: sarifLevel(check.Severity)},
+			Properties:           map[string]any{"category": check.Category},
+		}
+	}
+
+	results := make([]sarifResult, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		// Remote checks are only known from their results
+		if _, ok := rules[issue.Code]; !ok {
+			rules[issue.Code] = sarifRule{ID: issue.Code, HelpURI: issue.DocURL}
+		}
+
+		message := issue.Message
+		if issue.Suggestion != "" {
+			message += " " + issue.Suggestion
+		}
+
+		result := sarifResult{
+			RuleID:  issue.Code,
+			Level:   sarifLevel(issue.Severity),
+			Message: sarifMessage{Text: message},
+		}
+		if !issue.Position.IsZero() {
+			result.Locations = []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: relativePath(issue.Position.File)},
+					Region:           sarifRegion{StartLine: issue.Position.Line, StartColumn: issue.Position.Column},
+				},
+			}}
+		}
+		results = append(results, result)
+	}
+
+	ruleList := make([]sarifRule, 0, len(rules))
+	for _, rule := range rules {
+		ruleList = append(ruleList, rule)
+	}
+	sort.Slice(ruleList, func(i, j int) bool {
+		return ruleList[i].ID < ruleList[j].ID
+	})
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "portway",
+				InformationURI: DocsURL,
+				Rules:          ruleList,
+			}},
+			Results: results,
+		}},
+	})
+}
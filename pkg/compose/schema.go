@@ -0,0 +1,279 @@
+package compose
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// ExtensionSchema is the JSON Schema of the x-portway extension. The
+// top-level extension is described by #/$defs/project and the extension of
+// a service by #/$defs/service.
+//
+//go:embed x-portway.schema.json
+var ExtensionSchema []byte
+
+const extensionSchemaURL = "https://portway.dev/schemas/x-portway.json"
+
+var extensionSchemas = sync.OnceValues(func() (map[string]*jsonschema.Schema, error) {
+	document, err := jsonschema.UnmarshalJSON(bytes.NewReader(ExtensionSchema))
+	if err != nil {
+		return nil, err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.AssertFormat()
+	compiler.RegisterFormat(&jsonschema.Format{Name: "duration", Validate: validateDuration})
+	compiler.RegisterFormat(&jsonschema.Format{Name: "domain", Validate: validateDomain})
+	if err := compiler.AddResource(extensionSchemaURL, document); err != nil {
+		return nil, err
+	}
+
+	schemas := map[string]*jsonschema.Schema{}
+	for _, scope := range []string{"project", "service"} {
+		schema, err := compiler.Compile(extensionSchemaURL + "#/$defs/" + scope)
+		if err != nil {
+			return nil, err
+		}
+		schemas[scope] = schema
+	}
+	return schemas, nil
+})
+
+// validateDuration accepts Go durations, e.g. 30s or 5m, replacing the
+// ISO 8601 duration format of JSON Schema
+func validateDuration(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+	_, err := time.ParseDuration(s)
+	return err
+}
+
+var domainLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// validateDomain accepts domain names with at least two labels, e.g.
+// app.example.com, and wildcards such as *.example.com
+func validateDomain(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return nil
+	}
+
+	labels := strings.Split(strings.TrimPrefix(s, "*."), ".")
+	if len(labels) < 2 {
+		return errors.New("expected a name such as app.example.com")
+	}
+	for _, label := range labels {
+		if !domainLabel.MatchString(label) {
+			return fmt.Errorf("invalid label %q", label)
+		}
+	}
+	return nil
+}
+
+// ExtensionError is a value of an x-portway extension that does not match
+// the schema. Service is empty for the top-level extension and Path is
+// relative to the extension.
+type ExtensionError struct {
+	Service string
+	Path    []string
+	Message string
+}
+
+// Field returns the path of the value in the project, e.g.
+// services.web.x-portway.autoscaling
+func (e ExtensionError) Field() string {
+	segments := []string{PortwayExtensionKey}
+	if e.Service != "" {
+		segments = []string{"services", e.Service, PortwayExtensionKey}
+	}
+	return strings.Join(append(segments, e.Path...), ".")
+}
+
+// ValidateExtensions checks the x-portway extensions of a project against
+// the schema
+func ValidateExtensions(project *types.Project) ([]ExtensionError, error) {
+	schemas, err := extensionSchemas()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile %s schema: %w", PortwayExtensionKey, err)
+	}
+
+	var errs []ExtensionError
+	if raw, ok := project.Extensions[PortwayExtensionKey]; ok {
+		found, err := validateExtension(schemas["project"], raw)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, found...)
+	}
+
+	names := make([]string, 0, len(project.Services))
+	for name := range project.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw, ok := project.Services[name].Extensions[PortwayExtensionKey]
+		if !ok {
+			continue
+		}
+		found, err := validateExtension(schemas["service"], raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range found {
+			e.Service = name
+			errs = append(errs, e)
+		}
+	}
+
+	return errs, nil
+}
+
+// validateExtension returns an error for every failing keyword. The value
+// goes through JSON first, as the schema library only accepts JSON types.
+func validateExtension(schema *jsonschema.Schema, raw any) ([]ExtensionError, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+	value, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s extension: %w", PortwayExtensionKey, err)
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := schema.Validate(value); !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var errs []ExtensionError
+	var collect func(e *jsonschema.ValidationError)
+	collect = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			errs = append(errs, ExtensionError{
+				Path:    e.InstanceLocation,
+				Message: e.BasicOutput().Error.String(),
+			})
+			return
+		}
+		for _, cause := range e.Causes {
+			collect(cause)
+		}
+	}
+	collect(validationErr)
+	return errs, nil
+}
+
+// ExtensionSetting documents a setting of the x-portway extension
+type ExtensionSetting struct {
+	// Key is the path of the setting in the extension, with [] for the
+	// items of a list, e.g. endpoints[].port
+	Key         string
+	Type        string
+	Description string
+}
+
+// ExtensionSettings lists the settings of the top-level extension, for
+// scope project, or of a service, for scope service
+func ExtensionSettings(scope string) ([]ExtensionSetting, error) {
+	var schema schemaNode
+	if err := json.Unmarshal(ExtensionSchema, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse %s schema: %w", PortwayExtensionKey, err)
+	}
+
+	root, ok := schema.Defs[scope]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s scope %q", PortwayExtensionKey, scope)
+	}
+
+	var settings []ExtensionSetting
+	schema.collect(root, "", &settings)
+	return settings, nil
+}
+
+// schemaNode is the part of a JSON Schema that the documentation needs
+type schemaNode struct {
+	Ref         string                `json:"$ref"`
+	Type        string                `json:"type"`
+	Description string                `json:"description"`
+	Properties  map[string]schemaNode `json:"properties"`
+	Required    []string              `json:"required"`
+	Items       *schemaNode           `json:"items"`
+	Minimum     *float64              `json:"minimum"`
+	Maximum     *float64              `json:"maximum"`
+	Defs        map[string]schemaNode `json:"$defs"`
+}
+
+// resolve follows a local $ref, keeping the description of the reference
+func (s schemaNode) resolve(node schemaNode) schemaNode {
+	name, ok := strings.CutPrefix(node.Ref, "#/$defs/")
+	if !ok {
+		return node
+	}
+	target := s.resolve(s.Defs[name])
+	if node.Description != "" {
+		target.Description = node.Description
+	}
+	return target
+}
+
+func (s schemaNode) collect(node schemaNode, prefix string, settings *[]ExtensionSetting) {
+	keys := make([]string, 0, len(node.Properties))
+	for key := range node.Properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property := s.resolve(node.Properties[key])
+		path := prefix + key
+
+		typ := property.typeName()
+		var items *schemaNode
+		if property.Items != nil {
+			resolved := s.resolve(*property.Items)
+			items = &resolved
+			typ = "list of " + items.typeName()
+		}
+		if slices.Contains(node.Required, key) {
+			typ += ", required"
+		}
+		*settings = append(*settings, ExtensionSetting{Key: path, Type: typ, Description: property.Description})
+
+		switch {
+		case property.Type == "object":
+			s.collect(property, path+".", settings)
+		case items != nil && items.Type == "object":
+			s.collect(*items, path+"[].", settings)
+		}
+	}
+}
+
+// typeName describes the type of a value with its range, e.g. integer 1-100
+func (n schemaNode) typeName() string {
+	typ := n.Type
+	switch {
+	case typ == "object":
+		return "mapping"
+	case n.Minimum != nil && n.Maximum != nil:
+		return fmt.Sprintf("%s %g-%g", typ, *n.Minimum, *n.Maximum)
+	case n.Minimum != nil:
+		return fmt.Sprintf("%s >= %g", typ, *n.Minimum)
+	}
+	return typ
+}
@@ -0,0 +1,317 @@
+package compose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Position is a location in a compose file. Line and column start at 1.
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+// IsZero reports whether the position is unknown
+func (p Position) IsZero() bool {
+	return p.File == ""
+}
+
+func (p Position) String() string {
+	if p.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// SourceMap resolves dotted compose paths such as services.web.ports to
+// the position of the key in the compose files they were loaded from
+type SourceMap struct {
+	files []sourceFile
+}
+
+type sourceFile struct {
+	path string
+	root *yaml.Node
+}
+
+// NewSourceMap parses the compose files, in the order they are merged.
+// Files pulled in with include are added before the file including them,
+// as compose loads them first.
+func NewSourceMap(files []string) (*SourceMap, error) {
+	m := &SourceMap{}
+	seen := map[string]bool{}
+	for _, file := range files {
+		if err := m.add(file, seen); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+func (m *SourceMap) add(file string, seen map[string]bool) error {
+	if abs, err := filepath.Abs(file); err == nil {
+		file = abs
+	}
+	if seen[file] {
+		return nil
+	}
+	seen[file] = true
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+
+	for _, include := range includedFiles(&root) {
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(file), include)
+		}
+		// Includes may point at remote resources, which have no local source
+		if _, err := os.Stat(include); err != nil {
+			continue
+		}
+		if err := m.add(include, seen); err != nil {
+			return err
+		}
+	}
+
+	m.files = append(m.files, sourceFile{path: file, root: &root})
+	return nil
+}
+
+// includedFiles returns the paths listed in the top-level include section,
+// in both the short and the long syntax
+func includedFiles(root *yaml.Node) []string {
+	_, include := walkValue(root, []string{"include"})
+	if include == nil || include.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	var files []string
+	for _, item := range include.Content {
+		switch item.Kind {
+		case yaml.ScalarNode:
+			files = append(files, item.Value)
+		case yaml.MappingNode:
+			_, path := child(item, "path")
+			if path == nil {
+				continue
+			}
+			if path.Kind == yaml.ScalarNode {
+				files = append(files, path.Value)
+			}
+			for _, p := range path.Content {
+				files = append(files, p.Value)
+			}
+		}
+	}
+	return files
+}
+
+// Files returns the parsed files, in the order they are merged
+func (m *SourceMap) Files() []string {
+	files := make([]string, 0, len(m.files))
+	for _, file := range m.files {
+		files = append(files, file.path)
+	}
+	return files
+}
+
+// Lookup returns the position of path. When the full path is not written
+// in any file, the position of its deepest existing parent is returned.
+func (m *SourceMap) Lookup(path string) (Position, bool) {
+	if m == nil || path == "" {
+		return Position{}, false
+	}
+
+	// Remote issues may index sequences as ports[0]
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	segments := m.mergedIndex(strings.Split(path, "."))
+
+	var best Position
+	bestDepth := 0
+	// Later files override earlier ones, so they win ties
+	for i := len(m.files) - 1; i >= 0; i-- {
+		node, depth := walk(m.files[i].root, segments)
+		if depth > bestDepth {
+			best = Position{File: m.files[i].path, Line: node.Line, Column: node.Column}
+			bestDepth = depth
+		}
+	}
+
+	return best, bestDepth > 0
+}
+
+// mergedIndex cuts segments before the first index into a sequence that is
+// set in more than one file. Compose merges such sequences, so an index into
+// the merged list has no reliable position in any of them, and the parent key
+// is pointed at instead.
+func (m *SourceMap) mergedIndex(segments []string) []string {
+	for i := 1; i < len(segments); i++ {
+		if _, err := strconv.Atoi(segments[i]); err != nil {
+			continue
+		}
+
+		sequences := 0
+		for _, file := range m.files {
+			_, node := walkValue(file.root, segments[:i])
+			for node != nil && node.Kind == yaml.AliasNode {
+				node = node.Alias
+			}
+			if node != nil && node.Kind == yaml.SequenceNode {
+				sequences++
+			}
+		}
+		if sequences > 1 {
+			return segments[:i]
+		}
+	}
+	return segments
+}
+
+// Value is a node of a compose file as written, before compose merges and
+// normalizes it
+type Value struct {
+	Position Position
+	Node     *yaml.Node
+}
+
+// Values returns path as written in every file that sets it, in the order
+// the files are merged
+func (m *SourceMap) Values(path string) []Value {
+	if m == nil || path == "" {
+		return nil
+	}
+
+	var values []Value
+	segments := strings.Split(path, ".")
+	for _, file := range m.files {
+		key, node := walkValue(file.root, segments)
+		if node == nil {
+			continue
+		}
+		values = append(values, Value{
+			Position: Position{File: file.path, Line: key.Line, Column: key.Column},
+			Node:     node,
+		})
+	}
+	return values
+}
+
+// walk follows segments from the document root and returns the deepest node
+// reached, with the number of segments matched. Mapping keys are returned
+// rather than their values, so positions point at the key.
+func walk(root *yaml.Node, segments []string) (*yaml.Node, int) {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	var found *yaml.Node
+	depth := 0
+	for depth < len(segments) {
+		key, value, n := childSegments(node, segments[depth:])
+		if value == nil {
+			break
+		}
+		found = key
+		node = value
+		depth += n
+	}
+
+	return found, depth
+}
+
+// walkValue is walk returning the value node, or nil when the full path
+// does not exist
+func walkValue(root *yaml.Node, segments []string) (*yaml.Node, *yaml.Node) {
+	node := root
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	var key *yaml.Node
+	for len(segments) > 0 {
+		var n int
+		key, node, n = childSegments(node, segments)
+		if node == nil {
+			return nil, nil
+		}
+		segments = segments[n:]
+	}
+	return key, node
+}
+
+// childSegments is child for the first segment, or for the first segments
+// joined with dots when a key contains dots, such as a label name. It
+// returns how many segments were used.
+func childSegments(node *yaml.Node, segments []string) (*yaml.Node, *yaml.Node, int) {
+	for n := 1; n <= len(segments); n++ {
+		if key, value := child(node, strings.Join(segments[:n], ".")); value != nil {
+			return key, value, n
+		}
+	}
+	return nil, nil, 0
+}
+
+// child returns the key and value of a mapping entry, or the item of a
+// sequence at a numeric index
+func child(node *yaml.Node, segment string) (*yaml.Node, *yaml.Node) {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		index, err := strconv.Atoi(segment)
+		if err == nil && index >= 0 && index < len(node.Content) {
+			return node.Content[index], node.Content[index]
+		}
+	}
+	return nil, nil
+}
+
+// SourceLine is a numbered line of a compose file
+type SourceLine struct {
+	Number int
+	Text   string
+}
+
+// Excerpt returns the line at pos with up to context lines before and after
+func Excerpt(pos Position, context int) ([]SourceLine, error) {
+	data, err := os.ReadFile(pos.File)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if pos.Line < 1 || pos.Line > len(lines) {
+		return nil, fmt.Errorf("line %d is outside %s", pos.Line, pos.File)
+	}
+
+	start := max(pos.Line-context, 1)
+	end := min(pos.Line+context, len(lines))
+
+	excerpt := make([]SourceLine, 0, end-start+1)
+	for number := start; number <= end; number++ {
+		excerpt = append(excerpt, SourceLine{Number: number, Text: strings.TrimRight(lines[number-1], "\r")})
+	}
+	return excerpt, nil
+}
@@ -0,0 +1,67 @@
+package compose
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	dir := t.TempDir()
+	base := filepath.Join(dir, "compose.yaml")
+	override := filepath.Join(dir, "compose.override.yaml")
+	files := map[string]string{
+		base: `services:
+  web:
+    image: nginx:1.27
+    ports:
+      - "80:80"
+      - "443:443"
+    volumes:
+      - data:/data
+`,
+		override: `services:
+  web:
+    ports:
+      - "8080:8080"
+    cap_add:
+      - NET_ADMIN
+`,
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sources, err := NewSourceMap([]string{base, override})
+	if err != nil {
+		t.Fatalf("NewSourceMap: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		path string
+		want Position
+	}{
+		{name: "key", path: "services.web.image", want: Position{File: base, Line: 3, Column: 5}},
+		{name: "overridden key", path: "services.web.ports", want: Position{File: override, Line: 3, Column: 5}},
+		{name: "index of a list in one file", path: "services.web.volumes.0", want: Position{File: base, Line: 8, Column: 9}},
+		{name: "index of a list in the override", path: "services.web.cap_add[0]", want: Position{File: override, Line: 6, Column: 9}},
+		{name: "index of a merged list", path: "services.web.ports.1", want: Position{File: override, Line: 3, Column: 5}},
+		{name: "below an index of a merged list", path: "services.web.ports.0.published", want: Position{File: override, Line: 3, Column: 5}},
+		{name: "missing key", path: "services.web.healthcheck", want: Position{File: override, Line: 2, Column: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := sources.Lookup(tt.path)
+			if !ok {
+				t.Fatalf("Lookup(%s) found nothing", tt.path)
+			}
+			if got != tt.want {
+				t.Errorf("Lookup(%s) = %s, want %s", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,14 @@ package config
 
 import (
 	"cli/pkg/api"
+	"cli/pkg/compose"
+	"cli/pkg/compose/lint"
+	"cli/pkg/probe"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
@@ -22,9 +26,32 @@ type ComposeFileResolverType string
 
 // Environment represents configuration for a specific environment
 type Environment struct {
-	Region       string   `yaml:"region,omitempty"`
-	Domains      []string `yaml:"domains,omitempty"`
-	ComposeFiles []string `yaml:"compose-files"`
+	Region       string            `yaml:"region,omitempty"`
+	Domains      []string          `yaml:"domains,omitempty"`
+	ComposeFiles []string          `yaml:"compose-files"`
+	EnvFiles     []string          `yaml:"env-files,omitempty"`
+	Variables    map[string]string `yaml:"variables,omitempty"`
+	Readiness    *probe.Settings   `yaml:"readiness,omitempty"`
+	AutoRollback *AutoRollback     `yaml:"auto-rollback,omitempty"`
+}
+
+// AutoRollback restores the previously deployed version when a deployment
+// fails or its health score drops below MinHealthScore
+type AutoRollback struct {
+	Enabled        bool    `yaml:"enabled"`
+	MinHealthScore float32 `yaml:"min-health-score,omitempty"`
+}
+
+// GetEnvFiles returns the env files used for interpolation, relative to the config directory
+func (e *Environment) GetEnvFiles(configDir string) []string {
+	files := make([]string, 0, len(e.EnvFiles))
+	for _, file := range e.EnvFiles {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(configDir, file)
+		}
+		files = append(files, file)
+	}
+	return files
 }
 
 func (e *Environment) GetComposeFiles(configDir string) ([]string, error) {
@@ -57,11 +84,38 @@ func (e *Environment) GetComposeFiles(configDir string) ([]string, error) {
 	return files, nil
 }
 
+// InterpolationConfig controls which values compose ${VAR} interpolation may use
+type InterpolationConfig struct {
+	// AllowEnv lists shell environment variables that may be interpolated
+	AllowEnv []string `yaml:"allow-env,omitempty"`
+}
+
 type ProjectConfig struct {
 	DefaultEnvironment string                  `yaml:"default-environment,omitempty"`
+	DependsOn          []string                `yaml:"depends-on,omitempty"`
+	Interpolation      *InterpolationConfig    `yaml:"interpolation,omitempty"`
 	Environments       map[string]*Environment `yaml:"environments"`
 }
 
+// LoadOptions returns the ${VAR} interpolation sources of an environment of
+// the project: its env-files followed by envFiles, its variables and the
+// allow-env of the project
+func (p *ProjectConfig) LoadOptions(env *Environment, configDir string, envFiles []string) compose.LoadOptions {
+	return compose.LoadOptions{
+		EnvFiles:   append(env.GetEnvFiles(configDir), envFiles...),
+		Variables:  env.Variables,
+		AllowedEnv: p.GetAllowedEnv(),
+	}
+}
+
+// GetAllowedEnv returns the shell environment variables allowed for interpolation
+func (p *ProjectConfig) GetAllowedEnv() []string {
+	if p.Interpolation == nil {
+		return nil
+	}
+	return p.Interpolation.AllowEnv
+}
+
 func (p *ProjectConfig) GetEnvironment(name string) *Environment {
 	return p.Environments[name]
 }
@@ -78,6 +132,9 @@ type Config struct {
 	Projects       map[string]*ProjectConfig `yaml:"projects"`
 
 	Defaults *GlobalDefaultsConfig `yaml:"defaults,omitempty"`
+
+	// Lint is the validation policy applied by validate and deploy
+	Lint *lint.Policy `yaml:"lint,omitempty"`
 }
 
 func (c *Config) GetOrgSlug(client *api.ClientWithResponses) (string, error) {
@@ -98,24 +155,130 @@ func (c *Config) GetOrgSlug(client *api.ClientWithResponses) (string, error) {
 	return organization.Slug, nil
 }
 
+// LintRegistry returns the built-in checks with the custom rules of the
+// lint policy added
+func (c *Config) LintRegistry() (*lint.Registry, error) {
+	if c.Lint == nil || len(c.Lint.Rules) == 0 {
+		return lint.DefaultRegistry, nil
+	}
+	return lint.LoadRules(lint.DefaultRegistry, c.Lint.Rules, filepath.Dir(c.path))
+}
+
+// GetProjectSlug returns the default project, or the only project when
+// exactly one is configured.
 func (c *Config) GetProjectSlug() string {
-	return c.DefaultProject
+	if c.DefaultProject != "" {
+		return c.DefaultProject
+	}
+
+	if len(c.Projects) == 1 {
+		for name := range c.Projects {
+			return name
+		}
+	}
+
+	return ""
 }
 
 func (c *Config) GetProject() *ProjectConfig {
-	if c.DefaultProject != "" {
-		if proj, ok := c.Projects[c.DefaultProject]; ok && proj != nil {
-			return proj
+	return c.Projects[c.GetProjectSlug()]
+}
+
+// ResolveProjectSlug returns the project selected by name, falling back to
+// the default project when name is empty.
+func (c *Config) ResolveProjectSlug(name string) (string, error) {
+	if name == "" {
+		name = c.GetProjectSlug()
+		if name == "" {
+			return "", fmt.Errorf("multiple projects configured, select one with --project (%s)", strings.Join(c.ProjectNames(), ", "))
 		}
 	}
 
-	for _, proj := range c.Projects {
-		if proj != nil {
-			return proj
+	if proj, ok := c.Projects[name]; !ok || proj == nil {
+		return "", fmt.Errorf("project %q not found in config", name)
+	}
+
+	return name, nil
+}
+
+// LoadOptions returns the interpolation sources of the environment envName
+// of a project, the way deploy loads its compose files. With no project in
+// the config, only envFiles are used.
+func (c *Config) LoadOptions(projectName, envName string, envFiles []string) (compose.LoadOptions, error) {
+	if projectName == "" && len(c.Projects) == 0 {
+		return compose.LoadOptions{EnvFiles: envFiles}, nil
+	}
+
+	projectSlug, err := c.ResolveProjectSlug(projectName)
+	if err != nil {
+		return compose.LoadOptions{}, err
+	}
+
+	project := c.Projects[projectSlug]
+	env := project.GetEnvironment(envName)
+	if env == nil {
+		return compose.LoadOptions{}, fmt.Errorf("no environment %q found for project %q", envName, projectSlug)
+	}
+	return project.LoadOptions(env, filepath.Dir(c.path), envFiles), nil
+}
+
+// ProjectNames returns the configured project names in sorted order
+func (c *Config) ProjectNames() []string {
+	names := make([]string, 0, len(c.Projects))
+	for name := range c.Projects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ProjectDeployOrder returns every configured project ordered so that each
+// project comes after the projects it depends on.
+func (c *Config) ProjectDeployOrder() ([]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(c.Projects))
+	order := make([]string, 0, len(c.Projects))
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle between projects: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+		deps := append([]string(nil), c.Projects[name].DependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if proj, ok := c.Projects[dep]; !ok || proj == nil {
+				return fmt.Errorf("project %q depends on unknown project %q", name, dep)
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
 		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
 	}
 
-	return nil
+	for _, name := range c.ProjectNames() {
+		if c.Projects[name] == nil {
+			continue
+		}
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
 }
 
 // WriteConfig writes the config back to disk at the specified path
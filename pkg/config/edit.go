@@ -0,0 +1,67 @@
+package config
+
+import (
+	"cli/pkg/yamledit"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SaveDomains writes the domains of an environment to the config file. Only
+// the domains key is edited, so the comments and layout of the file are kept.
+func (c *Config) SaveDomains(projectSlug string, envName string) error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", c.path, err)
+	}
+	if len(root.Content) == 0 {
+		return fmt.Errorf("%s is empty", c.path)
+	}
+
+	projects := yamledit.MappingValue(root.Content[0], "projects")
+	environments := yamledit.MappingValue(yamledit.MappingValue(projects, projectSlug), "environments")
+	env := yamledit.MappingValue(environments, envName)
+	if env == nil || env.Kind != yaml.MappingNode {
+		return fmt.Errorf("environment %q of project %q not found in %s", envName, projectSlug, c.path)
+	}
+
+	domains := c.Projects[projectSlug].GetEnvironment(envName).Domains
+	if len(domains) == 0 {
+		yamledit.RemoveKey(env, "domains")
+	} else {
+		// Domains that stay keep their node and so their comments
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		existing := map[string]*yaml.Node{}
+		if current := yamledit.MappingValue(env, "domains"); current != nil {
+			node.Style = current.Style
+			for _, item := range current.Content {
+				existing[item.Value] = item
+			}
+		}
+		for _, domain := range domains {
+			item, ok := existing[domain]
+			if !ok {
+				item = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: domain}
+			}
+			node.Content = append(node.Content, item)
+		}
+		yamledit.SetKey(env, "domains", node)
+	}
+
+	fixed, err := yamledit.Encode(&root, data)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, fixed, info.Mode().Perm())
+}
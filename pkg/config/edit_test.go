@@ -0,0 +1,151 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSaveDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		domains []string
+		want    string
+	}{
+		{
+			name: "add",
+			input: `# Portway config
+version: "1.0"
+projects:
+    shop:
+        environments:
+            # Live traffic
+            production:
+                compose-files: [compose.yaml]
+                domains:
+                    - shop.example.com # main
+
+            staging:
+                compose-files: [compose.yaml]
+`,
+			domains: []string{"shop.example.com", "www.example.com"},
+			want: `# Portway config
+version: "1.0"
+projects:
+    shop:
+        environments:
+            # Live traffic
+            production:
+                compose-files: [compose.yaml]
+                domains:
+                    - shop.example.com # main
+                    - www.example.com
+
+            staging:
+                compose-files: [compose.yaml]
+`,
+		},
+		{
+			name: "first domain",
+			input: `projects:
+  shop:
+    environments:
+      production:
+        # Deployed from main
+        compose-files: [compose.yaml]
+`,
+			domains: []string{"shop.example.com"},
+			want: `projects:
+  shop:
+    environments:
+      production:
+        # Deployed from main
+        compose-files: [compose.yaml]
+        domains:
+          - shop.example.com
+`,
+		},
+		{
+			name: "flow style",
+			input: `projects:
+  shop:
+    environments:
+      production:
+        domains: [shop.example.com]
+        compose-files: [compose.yaml]
+`,
+			domains: []string{"shop.example.com", "www.example.com"},
+			want: `projects:
+  shop:
+    environments:
+      production:
+        domains: [shop.example.com, www.example.com]
+        compose-files: [compose.yaml]
+`,
+		},
+		{
+			name: "remove last",
+			input: `projects:
+  shop:
+    environments:
+      production:
+        domains:
+          - shop.example.com
+
+        compose-files: [compose.yaml]
+`,
+			domains: nil,
+			want: `projects:
+  shop:
+    environments:
+      production:
+
+        compose-files: [compose.yaml]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".portway.yaml")
+			if err := os.WriteFile(path, []byte(tt.input), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			cfg, err := LoadConfig(path)
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+			cfg.Projects["shop"].GetEnvironment("production").Domains = tt.domains
+
+			if err := cfg.SaveDomains("shop", "production"); err != nil {
+				t.Fatalf("SaveDomains: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tt.want {
+				t.Errorf("config =\n%s\nwant\n%s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaveDomainsMissingEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".portway.yaml")
+	input := "projects:\n  shop:\n    environments: {}\n"
+	if err := os.WriteFile(path, []byte(input), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if err := cfg.SaveDomains("shop", "production"); err == nil {
+		t.Error("SaveDomains succeeded for an environment missing from the file")
+	}
+}
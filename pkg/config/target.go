@@ -0,0 +1,54 @@
+package config
+
+import (
+	"cli/pkg/api"
+	"fmt"
+)
+
+// Target is a project environment from the config, resolved against the API
+type Target struct {
+	Config      *Config
+	Client      *api.ClientWithResponses
+	OrgSlug     string
+	ProjectSlug string
+	EnvName     string
+	Environment *Environment
+}
+
+// ResolveTarget loads the config at configPath and resolves the selected
+// project and environment, along with an API client and organization.
+func ResolveTarget(configPath string, projectName string, envName string) (*Target, error) {
+	cfg, err := LoadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	projectSlug, err := cfg.ResolveProjectSlug(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	env := cfg.Projects[projectSlug].GetEnvironment(envName)
+	if env == nil {
+		return nil, fmt.Errorf("no environment %q found for project %q", envName, projectSlug)
+	}
+
+	client, err := api.NewViperClientWithResponses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	orgSlug, err := cfg.GetOrgSlug(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Target{
+		Config:      cfg,
+		Client:      client,
+		OrgSlug:     orgSlug,
+		ProjectSlug: projectSlug,
+		EnvName:     envName,
+		Environment: env,
+	}, nil
+}
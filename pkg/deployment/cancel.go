@@ -0,0 +1,37 @@
+package deployment
+
+import (
+	"cli/pkg/api"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// ErrAlreadyFinished is returned when cancelling a deployment that already
+// reached a terminal state
+var ErrAlreadyFinished = errors.New("deployment already finished")
+
+// Cancel stops an in-flight deployment and returns its new state
+func Cancel(ctx context.Context, client *api.ClientWithResponses, id uuid.UUID) (State, error) {
+	response, err := client.CancelDeploymentWithResponse(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to cancel deployment: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		if response.JSON200 == nil {
+			return "", fmt.Errorf("failed to cancel deployment: %s", response.Status())
+		}
+		return State(response.JSON200.Status), nil
+	case http.StatusNotFound:
+		return "", fmt.Errorf("deployment %s not found", id)
+	case http.StatusConflict:
+		return "", ErrAlreadyFinished
+	default:
+		return "", fmt.Errorf("failed to cancel deployment: %s", response.Status())
+	}
+}
@@ -0,0 +1,36 @@
+package deployment
+
+// State is the lifecycle state of a deployment
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateDeploying State = "deploying"
+	StateDeployed  State = "deployed"
+	StateFailed    State = "failed"
+	StateCancelled State = "cancelled"
+)
+
+// Terminal reports whether a deployment in this state will not change anymore
+func (s State) Terminal() bool {
+	switch s {
+	case StateDeployed, StateFailed, StateCancelled:
+		return true
+	}
+	return false
+}
+
+// Succeeded reports whether the deployment finished successfully
+func (s State) Succeeded() bool {
+	return s == StateDeployed
+}
+
+// Known reports whether the CLI knows this state. Unknown states are
+// treated as in progress until the watcher times out.
+func (s State) Known() bool {
+	switch s {
+	case StatePending, StateDeploying, StateDeployed, StateFailed, StateCancelled:
+		return true
+	}
+	return false
+}
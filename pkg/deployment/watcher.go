@@ -0,0 +1,348 @@
+package deployment
+
+import (
+	"cli/pkg/api"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultInitialInterval    = 1 * time.Second
+	DefaultMaxInterval        = 15 * time.Second
+	DefaultMultiplier         = 1.5
+	DefaultJitter             = 0.2
+	DefaultMaxTransientErrors = 5
+)
+
+// NoJitter turns off the jitter of poll intervals in Options
+const NoJitter = -1
+
+// ErrTimeout is returned when a deployment does not finish within the timeout
+var ErrTimeout = errors.New("timed out waiting for deployment")
+
+// Client is the part of the API client the watcher needs
+type Client interface {
+	GetDeploymentWithResponse(ctx context.Context, deploymentId string, reqEditors ...api.RequestEditorFn) (*api.GetDeploymentResponse, error)
+}
+
+// Log is a single log line of a deployment
+type Log struct {
+	Timestamp time.Time
+	Stream    string
+	Message   string
+}
+
+// Deployment is a snapshot of a deployment
+type Deployment struct {
+	ID            uuid.UUID
+	ComposeFileID uuid.UUID
+	State         State
+	Logs          []Log
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// EventType identifies what an Event reports
+type EventType int
+
+const (
+	// EventLog reports a log line not seen before
+	EventLog EventType = iota
+	// EventState reports a state change, including the first state seen
+	EventState
+	// EventRetry reports a transient error that will be retried
+	EventRetry
+	// EventUnknownState reports a state the CLI does not know. The
+	// deployment is treated as in progress until the timeout.
+	EventUnknownState
+)
+
+// Event is emitted by the watcher while it polls a deployment
+type Event struct {
+	Type         EventType
+	DeploymentID uuid.UUID
+	State        State
+	Log          Log
+	Err          error
+}
+
+// Options configures a Watcher. Zero values use the defaults.
+type Options struct {
+	// Timeout bounds the whole wait, across all deployments of WaitAll;
+	// zero waits until the context is done
+	Timeout time.Duration
+
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+
+	// Jitter spreads poll intervals by up to ±Jitter, a fraction of the
+	// interval. Zero uses DefaultJitter and NoJitter turns it off.
+	Jitter float64
+
+	MaxTransientErrors int
+
+	// OnEvent is called for every log line, state change and retry
+	OnEvent func(Event)
+}
+
+// Watcher polls deployments until they reach a terminal state
+type Watcher struct {
+	client Client
+	opts   Options
+	clock  clock
+}
+
+// clock lets tests replace the time the watcher waits for
+type clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// NewWatcher returns a watcher using client to poll deployments
+func NewWatcher(client Client, opts Options) *Watcher {
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = DefaultInitialInterval
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = DefaultMaxInterval
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = DefaultMultiplier
+	}
+	switch {
+	case opts.Jitter == 0:
+		opts.Jitter = DefaultJitter
+	case opts.Jitter < 0:
+		opts.Jitter = 0
+	}
+	if opts.MaxTransientErrors <= 0 {
+		opts.MaxTransientErrors = DefaultMaxTransientErrors
+	}
+
+	return &Watcher{client: client, opts: opts, clock: realClock{}}
+}
+
+// Get fetches a deployment, retrying transient errors
+func (w *Watcher) Get(ctx context.Context, id uuid.UUID) (*Deployment, error) {
+	return w.get(ctx, id, time.Time{})
+}
+
+func (w *Watcher) get(ctx context.Context, id uuid.UUID, deadline time.Time) (*Deployment, error) {
+	interval := w.opts.InitialInterval
+	failures := 0
+
+	for {
+		deployment, err := w.fetch(ctx, id)
+		if err == nil {
+			return deployment, nil
+		}
+
+		failures++
+		if !isTransient(err) || failures > w.opts.MaxTransientErrors {
+			return nil, err
+		}
+		w.emit(Event{Type: EventRetry, DeploymentID: id, Err: err})
+
+		if err := w.sleep(ctx, w.jitter(interval), deadline); err != nil {
+			return nil, err
+		}
+		interval = w.next(interval)
+	}
+}
+
+// Wait polls a deployment until it reaches a terminal state. The returned
+// deployment holds the last state seen, also when an error is returned.
+func (w *Watcher) Wait(ctx context.Context, id uuid.UUID) (*Deployment, error) {
+	ctx, deadline, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	return w.wait(ctx, id, deadline)
+}
+
+// WaitAll waits for each deployment in turn, within a single timeout. It
+// stops at the first one that does not succeed and returns it.
+func (w *Watcher) WaitAll(ctx context.Context, ids []uuid.UUID) (*Deployment, error) {
+	ctx, deadline, cancel := w.withTimeout(ctx)
+	defer cancel()
+
+	var last *Deployment
+	for _, id := range ids {
+		deployment, err := w.wait(ctx, id, deadline)
+		if err != nil {
+			return deployment, err
+		}
+		last = deployment
+		if !deployment.State.Succeeded() {
+			return deployment, nil
+		}
+	}
+	return last, nil
+}
+
+// withTimeout returns the deadline of the timeout on the watcher clock, zero
+// without a timeout. The context also ends then, to stop requests in flight.
+func (w *Watcher) withTimeout(ctx context.Context) (context.Context, time.Time, context.CancelFunc) {
+	if w.opts.Timeout > 0 {
+		ctx, cancel := context.WithTimeout(ctx, w.opts.Timeout)
+		return ctx, w.clock.Now().Add(w.opts.Timeout), cancel
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return ctx, time.Time{}, cancel
+}
+
+func (w *Watcher) wait(ctx context.Context, id uuid.UUID, deadline time.Time) (*Deployment, error) {
+	var last *Deployment
+	seenLogs := make(map[Log]bool)
+	interval := w.opts.InitialInterval
+
+	for {
+		deployment, err := w.get(ctx, id, deadline)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && w.opts.Timeout > 0 {
+				return last, fmt.Errorf("%w %s after %s", ErrTimeout, id, w.opts.Timeout)
+			}
+			return last, err
+		}
+
+		progressed := last == nil || deployment.State != last.State
+		if progressed {
+			w.emit(Event{Type: EventState, DeploymentID: id, State: deployment.State})
+			if !deployment.State.Known() {
+				w.emit(Event{Type: EventUnknownState, DeploymentID: id, State: deployment.State, Err: fmt.Errorf("unknown deployment state %q", deployment.State)})
+			}
+		}
+		for _, log := range deployment.Logs {
+			if !seenLogs[log] {
+				seenLogs[log] = true
+				progressed = true
+				w.emit(Event{Type: EventLog, DeploymentID: id, State: deployment.State, Log: log})
+			}
+		}
+		last = deployment
+
+		if deployment.State.Terminal() {
+			return deployment, nil
+		}
+
+		// Poll quickly while the deployment makes progress, back off otherwise
+		if progressed {
+			interval = w.opts.InitialInterval
+		} else {
+			interval = w.next(interval)
+		}
+
+		if err := w.sleep(ctx, w.jitter(interval), deadline); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && w.opts.Timeout > 0 {
+				return last, fmt.Errorf("%w %s after %s", ErrTimeout, id, w.opts.Timeout)
+			}
+			return last, err
+		}
+	}
+}
+
+func (w *Watcher) fetch(ctx context.Context, id uuid.UUID) (*Deployment, error) {
+	response, err := w.client.GetDeploymentWithResponse(ctx, id.String())
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, &transientError{err: fmt.Errorf("failed to get deployment: %w", err)}
+	}
+
+	status := response.StatusCode()
+	switch {
+	case status == http.StatusOK && response.JSON200 != nil:
+	case status == http.StatusTooManyRequests || status >= 500:
+		return nil, &transientError{err: fmt.Errorf("failed to get deployment: %s", response.Status())}
+	case status == http.StatusNotFound:
+		return nil, fmt.Errorf("deployment %s not found", id)
+	default:
+		return nil, fmt.Errorf("failed to get deployment: %s", response.Status())
+	}
+
+	body := response.JSON200
+	deployment := &Deployment{
+		ID:            body.Id,
+		ComposeFileID: body.VersionId,
+		State:         State(body.Status),
+		CreatedAt:     body.CreatedAt,
+		UpdatedAt:     body.UpdatedAt,
+	}
+	if body.Logs != nil {
+		for _, l := range *body.Logs {
+			deployment.Logs = append(deployment.Logs, Log{Timestamp: l.Timestamp, Stream: l.Stream, Message: l.Log})
+		}
+	}
+
+	return deployment, nil
+}
+
+func (w *Watcher) emit(event Event) {
+	if w.opts.OnEvent != nil {
+		w.opts.OnEvent(event)
+	}
+}
+
+func (w *Watcher) next(interval time.Duration) time.Duration {
+	next := time.Duration(float64(interval) * w.opts.Multiplier)
+	if next > w.opts.MaxInterval {
+		return w.opts.MaxInterval
+	}
+	return next
+}
+
+// jitter spreads interval by up to ±Jitter so many CLIs do not poll in step
+func (w *Watcher) jitter(interval time.Duration) time.Duration {
+	delta := (rand.Float64()*2 - 1) * w.opts.Jitter * float64(interval)
+	return interval + time.Duration(delta)
+}
+
+// transientError is an error worth retrying, such as a 5xx or network error
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string { return e.err.Error() }
+func (e *transientError) Unwrap() error { return e.err }
+
+func isTransient(err error) bool {
+	var transient *transientError
+	return errors.As(err, &transient)
+}
+
+// sleep waits for d, or returns context.DeadlineExceeded at the deadline
+// when it comes first
+func (w *Watcher) sleep(ctx context.Context, d time.Duration, deadline time.Time) error {
+	if !deadline.IsZero() {
+		if left := deadline.Sub(w.clock.Now()); left < d {
+			if err := w.clock.Sleep(ctx, max(left, 0)); err != nil {
+				return err
+			}
+			return context.DeadlineExceeded
+		}
+	}
+	return w.clock.Sleep(ctx, d)
+}
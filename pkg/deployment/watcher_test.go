@@ -0,0 +1,289 @@
+package deployment
+
+import (
+	"cli/pkg/api"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeClock advances only when the watcher sleeps
+type fakeClock struct {
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+// fakeResponse is a reply of fakeClient, a 200 with status unless code is set
+type fakeResponse struct {
+	code   int
+	status State
+}
+
+// fakeClient replies with respond, given the number of earlier requests for
+// the deployment
+type fakeClient struct {
+	respond func(id string, call int) fakeResponse
+	calls   map[string]int
+}
+
+func (c *fakeClient) GetDeploymentWithResponse(ctx context.Context, id string, _ ...api.RequestEditorFn) (*api.GetDeploymentResponse, error) {
+	if c.calls == nil {
+		c.calls = map[string]int{}
+	}
+	reply := c.respond(id, c.calls[id])
+	c.calls[id]++
+
+	if reply.code != 0 && reply.code != http.StatusOK {
+		return &api.GetDeploymentResponse{HTTPResponse: &http.Response{StatusCode: reply.code, Status: http.StatusText(reply.code)}}, nil
+	}
+
+	body, _ := json.Marshal(map[string]any{"id": id, "status": reply.status})
+	response := &api.GetDeploymentResponse{Body: body, HTTPResponse: &http.Response{StatusCode: http.StatusOK}}
+	if err := json.Unmarshal(body, &response.JSON200); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// script replies with responses in turn, repeating the last one
+func script(responses ...fakeResponse) func(string, int) fakeResponse {
+	return func(_ string, call int) fakeResponse {
+		return responses[min(call, len(responses)-1)]
+	}
+}
+
+// deployingFor reports every deployment as deploying until it has been
+// watched for d on clock
+func deployingFor(clock *fakeClock, d time.Duration) func(string, int) fakeResponse {
+	started := map[string]time.Time{}
+	return func(id string, _ int) fakeResponse {
+		if _, ok := started[id]; !ok {
+			started[id] = clock.Now()
+		}
+		if clock.Now().Sub(started[id]) >= d {
+			return fakeResponse{status: StateDeployed}
+		}
+		return fakeResponse{status: StateDeploying}
+	}
+}
+
+func newTestWatcher(client Client, opts Options) (*Watcher, *fakeClock) {
+	opts.InitialInterval = 10 * time.Millisecond
+	opts.MaxInterval = 30 * time.Millisecond
+	opts.Multiplier = 1.5
+	opts.Jitter = NoJitter
+
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	watcher := NewWatcher(client, opts)
+	watcher.clock = clock
+	return watcher, clock
+}
+
+func TestWaitAllSharesTimeout(t *testing.T) {
+	client := &fakeClient{}
+	watcher, clock := newTestWatcher(client, Options{Timeout: 200 * time.Millisecond})
+	client.respond = deployingFor(clock, 80*time.Millisecond)
+	start := clock.Now()
+
+	// Each deployment finishes within the timeout, all three do not
+	_, err := watcher.WaitAll(context.Background(), []uuid.UUID{uuid.New(), uuid.New(), uuid.New()})
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("WaitAll error = %v, want ErrTimeout", err)
+	}
+	if elapsed := clock.Now().Sub(start); elapsed != 200*time.Millisecond {
+		t.Errorf("WaitAll waited %s, want the 200ms timeout shared by all deployments", elapsed)
+	}
+}
+
+func TestWaitAllWithinTimeout(t *testing.T) {
+	client := &fakeClient{}
+	watcher, clock := newTestWatcher(client, Options{Timeout: time.Second})
+	client.respond = deployingFor(clock, 20*time.Millisecond)
+
+	last, err := watcher.WaitAll(context.Background(), []uuid.UUID{uuid.New(), uuid.New()})
+	if err != nil {
+		t.Fatalf("WaitAll: %v", err)
+	}
+	if last == nil || !last.State.Succeeded() {
+		t.Errorf("WaitAll = %+v, want the last deployment deployed", last)
+	}
+}
+
+func TestWaitStates(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []fakeResponse
+		want      State
+		wantSlept []time.Duration
+	}{
+		{
+			name:      "backs off without progress",
+			responses: []fakeResponse{{status: StateDeploying}, {status: StateDeploying}, {status: StateDeploying}, {status: StateDeploying}, {status: StateDeployed}},
+			want:      StateDeployed,
+			wantSlept: []time.Duration{10 * time.Millisecond, 15 * time.Millisecond, 22500 * time.Microsecond, 30 * time.Millisecond},
+		},
+		{
+			name:      "polls quickly on progress",
+			responses: []fakeResponse{{status: StatePending}, {status: StatePending}, {status: StateDeploying}, {status: StateDeployed}},
+			want:      StateDeployed,
+			wantSlept: []time.Duration{10 * time.Millisecond, 15 * time.Millisecond, 10 * time.Millisecond},
+		},
+		{
+			name:      "cancelled",
+			responses: []fakeResponse{{status: StateDeploying}, {status: StateCancelled}},
+			want:      StateCancelled,
+			wantSlept: []time.Duration{10 * time.Millisecond},
+		},
+		{
+			name:      "failed",
+			responses: []fakeResponse{{status: StateFailed}},
+			want:      StateFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher, clock := newTestWatcher(&fakeClient{respond: script(tt.responses...)}, Options{})
+
+			d, err := watcher.Wait(context.Background(), uuid.New())
+			if err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+			if d.State != tt.want {
+				t.Errorf("State = %s, want %s", d.State, tt.want)
+			}
+			if !slices.Equal(clock.slept, tt.wantSlept) {
+				t.Errorf("slept %v, want %v", clock.slept, tt.wantSlept)
+			}
+		})
+	}
+}
+
+func TestWaitUnknownState(t *testing.T) {
+	var events []Event
+	client := &fakeClient{respond: script(fakeResponse{status: "paused"}, fakeResponse{status: "paused"}, fakeResponse{status: StateDeployed})}
+	watcher, _ := newTestWatcher(client, Options{OnEvent: func(e Event) { events = append(events, e) }})
+
+	d, err := watcher.Wait(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if !d.State.Succeeded() {
+		t.Errorf("State = %s, want the unknown state waited out", d.State)
+	}
+
+	var unknown []State
+	for _, e := range events {
+		if e.Type == EventUnknownState {
+			unknown = append(unknown, e.State)
+		}
+	}
+	if !slices.Equal(unknown, []State{"paused"}) {
+		t.Errorf("unknown state events = %v, want one for paused", unknown)
+	}
+}
+
+func TestWaitRetriesTransientErrors(t *testing.T) {
+	var retries int
+	client := &fakeClient{respond: script(
+		fakeResponse{code: http.StatusServiceUnavailable},
+		fakeResponse{code: http.StatusTooManyRequests},
+		fakeResponse{status: StateDeployed},
+	)}
+	watcher, clock := newTestWatcher(client, Options{OnEvent: func(e Event) {
+		if e.Type == EventRetry {
+			retries++
+		}
+	}})
+
+	d, err := watcher.Wait(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if !d.State.Succeeded() {
+		t.Errorf("State = %s, want deployed", d.State)
+	}
+	if retries != 2 {
+		t.Errorf("retries = %d, want 2", retries)
+	}
+	if want := []time.Duration{10 * time.Millisecond, 15 * time.Millisecond}; !slices.Equal(clock.slept, want) {
+		t.Errorf("slept %v, want backoff %v", clock.slept, want)
+	}
+}
+
+func TestWaitGivesUpOnErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      int
+		wantCalls int
+	}{
+		{name: "transient", code: http.StatusBadGateway, wantCalls: 4},
+		{name: "not found", code: http.StatusNotFound, wantCalls: 1},
+		{name: "client error", code: http.StatusForbidden, wantCalls: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := uuid.New()
+			client := &fakeClient{respond: script(fakeResponse{code: tt.code})}
+			watcher, _ := newTestWatcher(client, Options{MaxTransientErrors: 3})
+
+			_, err := watcher.Wait(context.Background(), id)
+			if err == nil {
+				t.Fatal("Wait succeeded, want an error")
+			}
+			if isTransient(err) != (tt.code >= 500) {
+				t.Errorf("Wait error = %v, transient %v", err, isTransient(err))
+			}
+			if tt.code == http.StatusNotFound && !strings.Contains(err.Error(), "not found") {
+				t.Errorf("Wait error = %v, want not found", err)
+			}
+			if calls := client.calls[id.String()]; calls != tt.wantCalls {
+				t.Errorf("requests = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	tests := []struct {
+		name   string
+		jitter float64
+		want   float64
+	}{
+		{name: "unset", jitter: 0, want: DefaultJitter},
+		{name: "set", jitter: 0.5, want: 0.5},
+		{name: "off", jitter: NoJitter, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			watcher := NewWatcher(&fakeClient{}, Options{Jitter: tt.jitter})
+			if watcher.opts.Jitter != tt.want {
+				t.Errorf("Jitter = %v, want %v", watcher.opts.Jitter, tt.want)
+			}
+		})
+	}
+
+	watcher := NewWatcher(&fakeClient{}, Options{Jitter: NoJitter})
+	for range 10 {
+		if got := watcher.jitter(time.Second); got != time.Second {
+			t.Fatalf("jitter(1s) = %s, want 1s without jitter", got)
+		}
+	}
+}
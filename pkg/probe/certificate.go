@@ -0,0 +1,74 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// WaitForCertificate polls url until it is served with a valid TLS
+// certificate. Only a response counts: certificate, connection and DNS
+// errors are retried, calling onWait with the error before every retry.
+// When ctx ends first, the last error is returned.
+func WaitForCertificate(ctx context.Context, url string, interval time.Duration, onWait func(err error)) error {
+	client := &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: &http.Transport{
+			// Intentionally do not skip cert verification here
+		},
+	}
+
+	var lastErr error
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return waitError(ctx, lastErr)
+		}
+		lastErr = err
+
+		if onWait != nil {
+			onWait(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return waitError(ctx, lastErr)
+		case <-time.After(interval):
+		}
+	}
+}
+
+// waitError returns the error of the last attempt, or the context error when
+// no attempt completed
+func waitError(ctx context.Context, lastErr error) error {
+	if lastErr != nil {
+		return lastErr
+	}
+	return ctx.Err()
+}
+
+// IsCertificateError reports whether err was caused by an invalid or not yet
+// issued certificate, e.g. an unknown authority or a hostname mismatch.
+func IsCertificateError(err error) bool {
+	var verificationErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	return errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthorityErr) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidErr)
+}
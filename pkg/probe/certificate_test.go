@@ -0,0 +1,90 @@
+package probe
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestIsCertificateError(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+
+	_, untrusted := http.Get(server.URL)
+	if untrusted == nil {
+		t.Fatal("GET with an untrusted certificate succeeded")
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "untrusted server", err: untrusted, want: true},
+		{name: "unknown authority", err: fmt.Errorf("get: %w", x509.UnknownAuthorityError{}), want: true},
+		{name: "hostname", err: fmt.Errorf("get: %w", x509.HostnameError{Host: "example.com"}), want: true},
+		{name: "expired", err: fmt.Errorf("get: %w", x509.CertificateInvalidError{Reason: x509.Expired}), want: true},
+		{name: "other", err: errors.New("dial tcp: connection refused"), want: false},
+		{name: "mentions x509", err: errors.New("x509: looks like one"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCertificateError(tt.err); got != tt.want {
+				t.Errorf("IsCertificateError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWaitForCertificate(t *testing.T) {
+	live := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer live.Close()
+
+	untrusted := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer untrusted.Close()
+
+	unreachable := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	unreachable.Close()
+
+	tests := []struct {
+		name            string
+		url             string
+		wantErr         bool
+		wantCertificate bool
+	}{
+		{name: "live", url: live.URL},
+		{name: "untrusted certificate", url: untrusted.URL, wantErr: true, wantCertificate: true},
+		{name: "unreachable", url: unreachable.URL, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			retries := 0
+			err := WaitForCertificate(ctx, tt.url, 10*time.Millisecond, func(error) { retries++ })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WaitForCertificate error = %v, want error %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("WaitForCertificate error = %v, want the last request error", err)
+			}
+			if got := IsCertificateError(err); got != tt.wantCertificate {
+				t.Errorf("IsCertificateError(%v) = %v, want %v", err, got, tt.wantCertificate)
+			}
+			if retries < 2 {
+				t.Errorf("retried %d time(s), want polling until the timeout", retries)
+			}
+		})
+	}
+}
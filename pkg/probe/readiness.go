@@ -0,0 +1,260 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Settings declares a readiness probe, as written in .portway.yaml or in
+// the x-portway compose extension. Durations use Go syntax, e.g. 30s or 5m.
+type Settings struct {
+	Disabled       bool   `yaml:"disabled,omitempty" json:"disabled,omitempty"`
+	Path           string `yaml:"path,omitempty" json:"path,omitempty"`
+	Timeout        string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	Interval       string `yaml:"interval,omitempty" json:"interval,omitempty"`
+	ExpectedStatus []int  `yaml:"expected-status,omitempty" json:"expected-status,omitempty"`
+	BodyMatch      string `yaml:"body-match,omitempty" json:"body-match,omitempty"`
+}
+
+// Options is a resolved readiness probe
+type Options struct {
+	Path           string
+	Timeout        time.Duration
+	Interval       time.Duration
+	ExpectedStatus []int
+	BodyMatch      *regexp.Regexp
+}
+
+const (
+	DefaultTimeout  = 5 * time.Minute
+	DefaultInterval = 5 * time.Second
+)
+
+// Merge returns s with every field set in override replacing its own
+func (s Settings) Merge(override *Settings) Settings {
+	if override == nil {
+		return s
+	}
+	if override.Disabled {
+		s.Disabled = true
+	}
+	if override.Path != "" {
+		s.Path = override.Path
+	}
+	if override.Timeout != "" {
+		s.Timeout = override.Timeout
+	}
+	if override.Interval != "" {
+		s.Interval = override.Interval
+	}
+	if len(override.ExpectedStatus) > 0 {
+		s.ExpectedStatus = override.ExpectedStatus
+	}
+	if override.BodyMatch != "" {
+		s.BodyMatch = override.BodyMatch
+	}
+	return s
+}
+
+// Resolve validates the settings and fills in defaults
+func (s Settings) Resolve() (*Options, error) {
+	opts := &Options{
+		Path:           s.Path,
+		Timeout:        DefaultTimeout,
+		Interval:       DefaultInterval,
+		ExpectedStatus: s.ExpectedStatus,
+	}
+
+	if opts.Path == "" {
+		opts.Path = "/"
+	}
+	if !strings.HasPrefix(opts.Path, "/") {
+		opts.Path = "/" + opts.Path
+	}
+
+	if s.Timeout != "" {
+		timeout, err := time.ParseDuration(s.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid readiness timeout %q: %w", s.Timeout, err)
+		}
+		opts.Timeout = timeout
+	}
+
+	if s.Interval != "" {
+		interval, err := time.ParseDuration(s.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid readiness interval %q: %w", s.Interval, err)
+		}
+		opts.Interval = interval
+	}
+
+	if s.BodyMatch != "" {
+		pattern, err := regexp.Compile(s.BodyMatch)
+		if err != nil {
+			return nil, fmt.Errorf("invalid readiness body-match %q: %w", s.BodyMatch, err)
+		}
+		opts.BodyMatch = pattern
+	}
+
+	return opts, nil
+}
+
+// CertificateInfo describes one certificate of the served TLS chain
+type CertificateInfo struct {
+	Subject  string
+	Issuer   string
+	NotAfter time.Time
+}
+
+// Result is the outcome of a single readiness attempt
+type Result struct {
+	URL         string
+	StatusCode  int
+	Latency     time.Duration
+	TLSVersion  string
+	Chain       []CertificateInfo
+	BodyMatched bool
+	Err         error
+}
+
+// Ready reports whether the attempt satisfied the probe
+func (r *Result) Ready(opts *Options) bool {
+	return r.Err == nil && r.statusExpected(opts) && (opts.BodyMatch == nil || r.BodyMatched)
+}
+
+// Reason describes why an attempt did not satisfy the probe
+func (r *Result) Reason(opts *Options) string {
+	switch {
+	case r.Err != nil && IsCertificateError(r.Err):
+		return "waiting for a valid SSL certificate"
+	case r.Err != nil:
+		return r.Err.Error()
+	case !r.statusExpected(opts):
+		return fmt.Sprintf("unexpected status %d", r.StatusCode)
+	default:
+		return fmt.Sprintf("response body does not match %q", opts.BodyMatch.String())
+	}
+}
+
+func (r *Result) statusExpected(opts *Options) bool {
+	if len(opts.ExpectedStatus) > 0 {
+		return slices.Contains(opts.ExpectedStatus, r.StatusCode)
+	}
+	return r.StatusCode >= 200 && r.StatusCode < 400
+}
+
+// ExpiresIn returns the time until the leaf certificate expires
+func (r *Result) ExpiresIn() time.Duration {
+	if len(r.Chain) == 0 {
+		return 0
+	}
+	return time.Until(r.Chain[0].NotAfter)
+}
+
+// Check runs a single readiness attempt against baseURL
+func Check(ctx context.Context, baseURL string, opts *Options) *Result {
+	url := strings.TrimSuffix(baseURL, "/") + opts.Path
+	result := &Result{URL: url}
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	result.Latency = time.Since(start)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer resp.Body.Close()
+
+	result.StatusCode = resp.StatusCode
+	if resp.TLS != nil {
+		result.TLSVersion = tls.VersionName(resp.TLS.Version)
+		result.Chain = certificateChain(resp.TLS)
+	}
+
+	if opts.BodyMatch != nil {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		if err != nil {
+			result.Err = fmt.Errorf("failed to read response body: %w", err)
+			return result
+		}
+		result.BodyMatched = opts.BodyMatch.Match(body)
+	}
+
+	return result
+}
+
+// WaitUntilReady runs the probe until it succeeds or opts.Timeout elapses.
+// onAttempt is called with the result of every failed attempt.
+func WaitUntilReady(ctx context.Context, baseURL string, opts *Options, onAttempt func(*Result)) (*Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	for {
+		result := Check(ctx, baseURL, opts)
+		if result.Ready(opts) {
+			return result, nil
+		}
+
+		if ctx.Err() != nil {
+			return result, fmt.Errorf("not ready after %s: %s", opts.Timeout, result.Reason(opts))
+		}
+
+		if onAttempt != nil {
+			onAttempt(result)
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, fmt.Errorf("not ready after %s: %s", opts.Timeout, result.Reason(opts))
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+func certificateChain(state *tls.ConnectionState) []CertificateInfo {
+	certs := state.PeerCertificates
+	if len(state.VerifiedChains) > 0 {
+		certs = state.VerifiedChains[0]
+	}
+
+	chain := make([]CertificateInfo, 0, len(certs))
+	for _, cert := range certs {
+		chain = append(chain, CertificateInfo{
+			Subject:  certificateName(cert.Subject.CommonName, cert),
+			Issuer:   cert.Issuer.CommonName,
+			NotAfter: cert.NotAfter,
+		})
+	}
+	return chain
+}
+
+func certificateName(commonName string, cert *x509.Certificate) string {
+	if commonName != "" {
+		return commonName
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return cert.Subject.String()
+}
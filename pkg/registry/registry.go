@@ -0,0 +1,350 @@
+// Package registry looks up images in OCI and Docker v2 registries with
+// anonymous token auth.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/distribution/reference"
+)
+
+// Manifest media types
+const (
+	mediaTypeOCIIndex       = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIManifest    = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeDockerList     = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+var manifestTypes = strings.Join([]string{mediaTypeOCIIndex, mediaTypeDockerList, mediaTypeOCIManifest, mediaTypeDockerManifest}, ", ")
+
+var (
+	// ErrNotFound is returned when the registry has no such image
+	ErrNotFound = errors.New("image not found")
+
+	// ErrUnauthorized is returned when the image needs credentials
+	ErrUnauthorized = errors.New("image requires authentication")
+)
+
+// Platform is an operating system and architecture an image is built for
+type Platform struct {
+	OS           string `json:"os"`
+	Architecture string `json:"architecture"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+func (p Platform) String() string {
+	s := p.OS + "/" + p.Architecture
+	if p.Variant != "" {
+		s += "/" + p.Variant
+	}
+	return s
+}
+
+// Image is what the registry reports about an image reference
+type Image struct {
+	Reference string
+	Digest    string
+	MediaType string
+	Platforms []Platform
+
+	// Size is the compressed size of the linux/amd64 variant, 0 when the
+	// image has none
+	Size int64
+}
+
+// HasPlatform reports whether the image is built for os/architecture
+func (i *Image) HasPlatform(os, architecture string) bool {
+	for _, platform := range i.Platforms {
+		if platform.OS == os && platform.Architecture == architecture {
+			return true
+		}
+	}
+	return false
+}
+
+// Client looks up images. The zero value is not usable, use NewClient.
+type Client struct {
+	HTTPClient *http.Client
+
+	// Endpoint returns the base URL of a registry host. It defaults to
+	// https, or http for localhost, and can point at a fake registry.
+	Endpoint func(host string) string
+
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+// NewClient returns a client for public registries
+func NewClient() *Client {
+	return &Client{
+		HTTPClient: &http.Client{Timeout: 15 * time.Second},
+		Endpoint:   DefaultEndpoint,
+		tokens:     map[string]string{},
+	}
+}
+
+// DefaultEndpoint returns the base URL of a registry host
+func DefaultEndpoint(host string) string {
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	if hostname == "localhost" || net.ParseIP(hostname).IsLoopback() {
+		return "http://" + host
+	}
+	return "https://" + host
+}
+
+// Inspect looks up an image. It checks that the manifest exists with a HEAD
+// request, then reads the platforms and the size of the linux/amd64 variant.
+func (c *Client) Inspect(ctx context.Context, image string) (*Image, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image %q: %w", image, err)
+	}
+	named = reference.TagNameOnly(named)
+
+	host := reference.Domain(named)
+	repository := reference.Path(named)
+	ref := ""
+	if digested, ok := named.(reference.Digested); ok {
+		ref = digested.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		ref = tagged.Tag()
+	}
+
+	head, err := c.do(ctx, http.MethodHead, host, repository, "/manifests/"+ref, manifestTypes)
+	if err != nil {
+		return nil, err
+	}
+	head.Body.Close()
+
+	result := &Image{
+		Reference: reference.FamiliarString(named),
+		Digest:    head.Header.Get("Docker-Content-Digest"),
+		MediaType: mediaType(head.Header.Get("Content-Type")),
+	}
+
+	var index manifest
+	if err := c.getJSON(ctx, host, repository, "/manifests/"+ref, manifestTypes, &index); err != nil {
+		return nil, err
+	}
+	if result.MediaType == "" {
+		result.MediaType = index.MediaType
+	}
+
+	if len(index.Manifests) > 0 {
+		var amd64 string
+		for _, entry := range index.Manifests {
+			if entry.Platform == nil || entry.Platform.OS == "unknown" {
+				// Attestations are listed as unknown/unknown
+				continue
+			}
+			result.Platforms = append(result.Platforms, *entry.Platform)
+			if amd64 == "" && entry.Platform.OS == "linux" && entry.Platform.Architecture == "amd64" {
+				amd64 = entry.Digest
+			}
+		}
+		if amd64 == "" {
+			return result, nil
+		}
+
+		var platformManifest manifest
+		if err := c.getJSON(ctx, host, repository, "/manifests/"+amd64, manifestTypes, &platformManifest); err != nil {
+			return nil, err
+		}
+		result.Size = platformManifest.size()
+		return result, nil
+	}
+
+	// A single manifest names its platform in the image config
+	var config Platform
+	if err := c.getJSON(ctx, host, repository, "/blobs/"+index.Config.Digest, "*/*", &config); err != nil {
+		return nil, err
+	}
+	result.Platforms = []Platform{config}
+	if config.OS == "linux" && config.Architecture == "amd64" {
+		result.Size = index.size()
+	}
+	return result, nil
+}
+
+type descriptor struct {
+	MediaType string    `json:"mediaType"`
+	Digest    string    `json:"digest"`
+	Size      int64     `json:"size"`
+	Platform  *Platform `json:"platform,omitempty"`
+}
+
+// manifest is an image index or an image manifest
+type manifest struct {
+	MediaType string       `json:"mediaType"`
+	Manifests []descriptor `json:"manifests"`
+	Config    descriptor   `json:"config"`
+	Layers    []descriptor `json:"layers"`
+}
+
+func (m manifest) size() int64 {
+	size := m.Config.Size
+	for _, layer := range m.Layers {
+		size += layer.Size
+	}
+	return size
+}
+
+func mediaType(contentType string) string {
+	value, _, _ := strings.Cut(contentType, ";")
+	return strings.TrimSpace(value)
+}
+
+func (c *Client) getJSON(ctx context.Context, host, repository, path, accept string, v any) error {
+	resp, err := c.do(ctx, http.MethodGet, host, repository, path, accept)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4<<20)).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	return nil
+}
+
+// do sends a request to the registry, fetching an anonymous token when the
+// registry asks for one
+func (c *Client) do(ctx context.Context, method, host, repository, path, accept string) (*http.Response, error) {
+	endpoint := c.Endpoint(host) + "/v2/" + repository + path
+	scope := "repository:" + repository + ":pull"
+
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", accept)
+		if token := c.token(host, scope); token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := c.HTTPClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return resp, nil
+		case resp.StatusCode == http.StatusUnauthorized && attempt == 0:
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+			if err := c.authenticate(ctx, host, scope, challenge); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		resp.Body.Close()
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return nil, ErrNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			// Registries answer with 401 for private and missing
+			// repositories alike
+			return nil, ErrUnauthorized
+		default:
+			return nil, fmt.Errorf("registry %s returned %d for %s", host, resp.StatusCode, path)
+		}
+	}
+}
+
+func (c *Client) token(host, scope string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.tokens[host+" "+scope]
+}
+
+// authenticate fetches an anonymous token for a Bearer challenge
+func (c *Client) authenticate(ctx context.Context, host, scope, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return ErrUnauthorized
+	}
+
+	values := parseChallenge(params)
+	realm := values["realm"]
+	if realm == "" {
+		return ErrUnauthorized
+	}
+
+	key := host + " " + scope
+	query := url.Values{}
+	if service := values["service"]; service != "" {
+		query.Set("service", service)
+	}
+	if challengeScope := values["scope"]; challengeScope != "" {
+		scope = challengeScope
+	}
+	query.Set("scope", scope)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ErrUnauthorized
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("failed to decode registry token: %w", err)
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+
+	c.mu.Lock()
+	c.tokens[key] = token.Token
+	c.mu.Unlock()
+	return nil
+}
+
+// parseChallenge parses the key="value" pairs of a WWW-Authenticate header
+func parseChallenge(params string) map[string]string {
+	values := map[string]string{}
+	for params != "" {
+		var key, value string
+		key, params, _ = strings.Cut(params, "=")
+		key = strings.TrimSpace(strings.TrimLeft(key, ", "))
+		if strings.HasPrefix(params, `"`) {
+			value, params, _ = strings.Cut(params[1:], `"`)
+		} else {
+			value, params, _ = strings.Cut(params, ",")
+		}
+		values[strings.ToLower(key)] = value
+		params = strings.TrimLeft(params, ", ")
+	}
+	return values
+}
@@ -0,0 +1,358 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeRegistry is a v2 registry serving manifests and blobs from memory.
+// With auth set, it answers 401 with a Bearer challenge until the token of
+// its token endpoint is sent.
+type fakeRegistry struct {
+	*httptest.Server
+
+	auth      bool
+	manifests map[string]fakeManifest
+	blobs     map[string]any
+
+	mu            sync.Mutex
+	requests      []string
+	tokenRequests []string
+}
+
+type fakeManifest struct {
+	mediaType string
+	digest    string
+	body      any
+}
+
+const fakeToken = "fake-token"
+
+func newFakeRegistry(t *testing.T, auth bool) *fakeRegistry {
+	t.Helper()
+
+	r := &fakeRegistry{auth: auth, manifests: map[string]fakeManifest{}, blobs: map[string]any{}}
+	r.Server = httptest.NewServer(http.HandlerFunc(r.serve))
+	t.Cleanup(r.Close)
+	return r
+}
+
+// addManifest serves body for repository at every ref, e.g. a tag and a
+// digest
+func (r *fakeRegistry) addManifest(repository, mediaType, digest string, body any, refs ...string) {
+	for _, ref := range refs {
+		r.manifests[repository+"@"+ref] = fakeManifest{mediaType: mediaType, digest: digest, body: body}
+	}
+}
+
+func (r *fakeRegistry) client() *Client {
+	client := NewClient()
+	client.HTTPClient = r.Client()
+	client.Endpoint = func(host string) string { return r.URL }
+	return client
+}
+
+// image returns a reference to an image of the registry
+func (r *fakeRegistry) image(repository, ref string) string {
+	host := strings.TrimPrefix(r.URL, "http://")
+	if strings.HasPrefix(ref, "sha256:") {
+		return host + "/" + repository + "@" + ref
+	}
+	return host + "/" + repository + ":" + ref
+}
+
+func (r *fakeRegistry) serve(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		r.mu.Lock()
+		r.tokenRequests = append(r.tokenRequests, req.URL.RawQuery)
+		r.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]string{"token": fakeToken})
+		return
+	}
+
+	r.mu.Lock()
+	r.requests = append(r.requests, req.Method+" "+req.URL.Path)
+	r.mu.Unlock()
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	repository, ref, isManifest := strings.Cut(path, "/manifests/")
+	if !isManifest {
+		repository, ref, _ = strings.Cut(path, "/blobs/")
+	}
+
+	if r.auth && req.Header.Get("Authorization") != "Bearer "+fakeToken {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="fake",scope="repository:%s:pull"`, r.URL, repository))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if !isManifest {
+		blob, ok := r.blobs[ref]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(blob)
+		return
+	}
+
+	m, ok := r.manifests[repository+"@"+ref]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", m.mediaType)
+	w.Header().Set("Docker-Content-Digest", m.digest)
+	if req.Method == http.MethodHead {
+		return
+	}
+	json.NewEncoder(w).Encode(m.body)
+}
+
+func imageManifest(configDigest string, sizes ...int64) map[string]any {
+	layers := []map[string]any{}
+	for i, size := range sizes {
+		layers = append(layers, map[string]any{"digest": fmt.Sprintf("sha256:layer%d", i), "size": size})
+	}
+	return map[string]any{
+		"mediaType": mediaTypeOCIManifest,
+		"config":    map[string]any{"digest": configDigest, "size": 100},
+		"layers":    layers,
+	}
+}
+
+func imageIndex(platforms map[string]Platform) map[string]any {
+	var manifests []map[string]any
+	for digest, platform := range platforms {
+		manifests = append(manifests, map[string]any{"digest": digest, "platform": platform})
+	}
+	return map[string]any{"mediaType": mediaTypeOCIIndex, "manifests": manifests}
+}
+
+func TestInspectIndex(t *testing.T) {
+	r := newFakeRegistry(t, false)
+	r.addManifest("library/app", mediaTypeOCIIndex, "sha256:index", imageIndex(map[string]Platform{
+		"sha256:amd64":       {OS: "linux", Architecture: "amd64"},
+		"sha256:arm64":       {OS: "linux", Architecture: "arm64", Variant: "v8"},
+		"sha256:attestation": {OS: "unknown", Architecture: "unknown"},
+	}), "1.0")
+	r.addManifest("library/app", mediaTypeOCIManifest, "sha256:amd64", imageManifest("sha256:config", 1000, 2000), "sha256:amd64")
+
+	image, err := r.client().Inspect(context.Background(), r.image("library/app", "1.0"))
+	if err != nil {
+		t.Fatalf("Inspect: %v", err)
+	}
+
+	if image.Digest != "sha256:index" {
+		t.Errorf("Digest = %q, want sha256:index", image.Digest)
+	}
+	if image.MediaType != mediaTypeOCIIndex {
+		t.Errorf("MediaType = %q, want %q", image.MediaType, mediaTypeOCIIndex)
+	}
+	if len(image.Platforms) != 2 {
+		t.Errorf("Platforms = %v, want linux/amd64 and linux/arm64/v8 without the attestation", image.Platforms)
+	}
+	if !image.HasPlatform("linux", "amd64") || !image.HasPlatform("linux", "arm64") {
+		t.Errorf("Platforms = %v, want linux/amd64 and linux/arm64", image.Platforms)
+	}
+	if image.Size != 3100 {
+		t.Errorf("Size = %d, want the config and layers of the amd64 manifest, 3100", image.Size)
+	}
+
+	want := []string{"HEAD /v2/library/app/manifests/1.0", "GET /v2/library/app/manifests/1.0", "GET /v2/library/app/manifests/sha256:amd64"}
+	if strings.Join(r.requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", r.requests, want)
+	}
+}
+
+func TestInspectIndexWithoutAMD64(t *testing.T) {
+	r := newFakeRegistry(t, false)
+	r.addManifest("library/app", mediaTypeDockerList, "sha256:index", imageIndex(map[string]Platform{
+		"sha256:arm64": {OS: "linux", Architecture: "arm64"},
+	}), "arm-only")
+
+	image, err := r.client().Inspect(context.Background(), r.image("library/app", "arm-only"))
+	if err != nil {
+		t.Fatalf("Inspect: %v", err)
+	}
+
+	if image.HasPlatform("linux", "amd64") {
+		t.Errorf("Platforms = %v, want no linux/amd64", image.Platforms)
+	}
+	if !image.HasPlatform("linux", "arm64") {
+		t.Errorf("Platforms = %v, want linux/arm64", image.Platforms)
+	}
+	if image.Size != 0 {
+		t.Errorf("Size = %d, want 0 without a linux/amd64 variant", image.Size)
+	}
+	for _, request := range r.requests {
+		if strings.Contains(request, "sha256:arm64") {
+			t.Errorf("fetched %s, want no platform manifest fetched", request)
+		}
+	}
+}
+
+func TestInspectSingleManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		platform Platform
+		wantSize int64
+	}{
+		{name: "amd64", platform: Platform{OS: "linux", Architecture: "amd64"}, wantSize: 600},
+		{name: "arm64", platform: Platform{OS: "linux", Architecture: "arm64"}, wantSize: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFakeRegistry(t, false)
+			r.addManifest("team/api", mediaTypeDockerManifest, "sha256:manifest", imageManifest("sha256:config", 500), "2.3")
+			r.blobs["sha256:config"] = tt.platform
+
+			image, err := r.client().Inspect(context.Background(), r.image("team/api", "2.3"))
+			if err != nil {
+				t.Fatalf("Inspect: %v", err)
+			}
+
+			if len(image.Platforms) != 1 || image.Platforms[0] != tt.platform {
+				t.Errorf("Platforms = %v, want [%s] from the image config", image.Platforms, tt.platform)
+			}
+			if image.Size != tt.wantSize {
+				t.Errorf("Size = %d, want %d", image.Size, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestInspectDigest(t *testing.T) {
+	r := newFakeRegistry(t, false)
+	r.addManifest("team/api", mediaTypeOCIManifest, "sha256:abc", imageManifest("sha256:config", 10), "sha256:"+strings.Repeat("a", 64))
+	r.blobs["sha256:config"] = Platform{OS: "linux", Architecture: "amd64"}
+
+	image, err := r.client().Inspect(context.Background(), r.image("team/api", "sha256:"+strings.Repeat("a", 64)))
+	if err != nil {
+		t.Fatalf("Inspect: %v", err)
+	}
+	if image.Size != 110 {
+		t.Errorf("Size = %d, want 110", image.Size)
+	}
+}
+
+func TestInspectBearerChallenge(t *testing.T) {
+	r := newFakeRegistry(t, true)
+	r.addManifest("library/app", mediaTypeOCIManifest, "sha256:manifest", imageManifest("sha256:config", 10), "1.0", "2.0")
+	r.blobs["sha256:config"] = Platform{OS: "linux", Architecture: "amd64"}
+
+	client := r.client()
+	for _, tag := range []string{"1.0", "2.0"} {
+		if _, err := client.Inspect(context.Background(), r.image("library/app", tag)); err != nil {
+			t.Fatalf("Inspect %s: %v", tag, err)
+		}
+	}
+
+	if len(r.tokenRequests) != 1 {
+		t.Fatalf("token requests = %q, want the token fetched once and reused", r.tokenRequests)
+	}
+	if !strings.Contains(r.tokenRequests[0], "service=fake") || !strings.Contains(r.tokenRequests[0], "scope=repository%3Alibrary%2Fapp%3Apull") {
+		t.Errorf("token request = %q, want the service and scope of the challenge", r.tokenRequests[0])
+	}
+}
+
+func TestInspectErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    error
+	}{
+		{
+			name:    "not found",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNotFound) },
+			want:    ErrNotFound,
+		},
+		{
+			name: "basic challenge",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("WWW-Authenticate", `Basic realm="registry"`)
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			want: ErrUnauthorized,
+		},
+		{
+			name: "token refused",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/token" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				w.Header().Set("WWW-Authenticate", `Bearer realm="http://`+r.Host+`/token",service="fake"`)
+				w.WriteHeader(http.StatusUnauthorized)
+			},
+			want: ErrUnauthorized,
+		},
+		{
+			name:    "forbidden",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusForbidden) },
+			want:    ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			client := NewClient()
+			client.Endpoint = func(host string) string { return server.URL }
+
+			_, err := client.Inspect(context.Background(), "registry.example.com/team/missing:1.0")
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Inspect error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInspectMissingTag(t *testing.T) {
+	r := newFakeRegistry(t, true)
+	r.addManifest("library/app", mediaTypeOCIManifest, "sha256:manifest", imageManifest("sha256:config", 10), "1.0")
+
+	_, err := r.client().Inspect(context.Background(), r.image("library/app", "9.9"))
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Inspect error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestDefaultEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"docker.io":        "https://registry-1.docker.io",
+		"ghcr.io":          "https://ghcr.io",
+		"localhost:5000":   "http://localhost:5000",
+		"127.0.0.1:5000":   "http://127.0.0.1:5000",
+		"registry.example": "https://registry.example",
+	}
+	for host, want := range tests {
+		if got := DefaultEndpoint(host); got != want {
+			t.Errorf("DefaultEndpoint(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	got := parseChallenge(`realm="https://auth.docker.io/token",service="registry.docker.io",scope="repository:library/nginx:pull"`)
+	want := map[string]string{
+		"realm":   "https://auth.docker.io/token",
+		"service": "registry.docker.io",
+		"scope":   "repository:library/nginx:pull",
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("parseChallenge()[%q] = %q, want %q", key, got[key], value)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package secrets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/types"
+)
+
+// Finding is a literal value in a compose project that looks like a credential
+type Finding struct {
+	Service string
+	Field   string
+	Key     string
+	Value   string
+	Reason  string
+}
+
+// ScanProject looks for literal credentials in the environment, build
+// args, labels and command of every service
+func ScanProject(project *types.Project) []Finding {
+	var findings []Finding
+
+	for _, serviceName := range sortedServiceNames(project) {
+		service := project.Services[serviceName]
+		prefix := "services." + serviceName
+
+		findings = append(findings, scanMapping(serviceName, prefix+".environment", service.Environment)...)
+		if service.Build != nil {
+			findings = append(findings, scanMapping(serviceName, prefix+".build.args", service.Build.Args)...)
+		}
+
+		labels := make(types.MappingWithEquals, len(service.Labels))
+		for key, value := range service.Labels {
+			labels[key] = &value
+		}
+		findings = append(findings, scanMapping(serviceName, prefix+".labels", labels)...)
+
+		for i, arg := range service.Command {
+			// Flags such as --api-key=value carry their name like a variable
+			key, value, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+			if !ok || !strings.HasPrefix(arg, "-") {
+				key, value = "", arg
+			}
+			if reason, found := DetectCredential(key, value); found {
+				findings = append(findings, Finding{
+					Service: serviceName,
+					Field:   fmt.Sprintf("%s.command.%d", prefix, i),
+					Key:     fmt.Sprintf("command[%d]", i),
+					Value:   value,
+					Reason:  reason,
+				})
+			}
+		}
+	}
+
+	return findings
+}
+
+// scanMapping looks for credentials in the values of a key/value section
+func scanMapping(service string, field string, values types.MappingWithEquals) []Finding {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var findings []Finding
+	for _, key := range keys {
+		value := values[key]
+		if value == nil {
+			continue
+		}
+
+		if reason, found := DetectCredential(key, *value); found {
+			findings = append(findings, Finding{
+				Service: service,
+				Field:   field + "." + key,
+				Key:     key,
+				Value:   *value,
+				Reason:  reason,
+			})
+		}
+	}
+	return findings
+}
+
+// ExternalSecrets returns the Portway secret names referenced by top-level
+// compose secrets marked as external.
+func ExternalSecrets(project *types.Project) []string {
+	var names []string
+	for key, secret := range project.Secrets {
+		if !secret.External {
+			continue
+		}
+		name := secret.Name
+		if name == "" {
+			name = key
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LocalSecrets returns the top-level compose secrets whose value is read from
+// the local machine, which would not exist once deployed.
+func LocalSecrets(project *types.Project) map[string]string {
+	local := map[string]string{}
+	for key, secret := range project.Secrets {
+		switch {
+		case bool(secret.External):
+			continue
+		case secret.File != "":
+			local[key] = "file " + secret.File
+		case secret.Environment != "":
+			local[key] = "environment variable " + secret.Environment
+		case secret.Content != "":
+			local[key] = "inline content"
+		}
+	}
+	return local
+}
+
+func sortedServiceNames(project *types.Project) []string {
+	names := make([]string, 0, len(project.Services))
+	for name := range project.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// credentialKeyPattern matches variable names that usually hold credentials
+var credentialKeyPattern = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|ACCESS_?KEY|PRIVATE_?KEY|CREDENTIALS?)`)
+
+// credentialValuePatterns matches well-known credential formats regardless of the variable name
+var credentialValuePatterns = []struct {
+	Name    string
+	Pattern *regexp.Regexp
+}{
+	{"AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}\b|\bgithub_pat_[A-Za-z0-9_]{22,}\b`)},
+	{"Stripe key", regexp.MustCompile(`\b(sk|rk)_(live|test)_[A-Za-z0-9]{16,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"npm token", regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+	{"SendGrid key", regexp.MustCompile(`\bSG\.[A-Za-z0-9_-]{22}\.[A-Za-z0-9_-]{43}\b`)},
+	{"private key", regexp.MustCompile(`-----BEGIN ([A-Z ]+ )?PRIVATE KEY-----`)},
+	{"connection string with password", regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s:/@]+:[^\s@/]+@`)},
+}
+
+// DetectCredential reports whether a literal key/value pair looks like a
+// credential, and why.
+func DetectCredential(key string, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", false
+	}
+
+	for _, p := range credentialValuePatterns {
+		if p.Pattern.MatchString(value) {
+			return "value looks like a " + p.Name, true
+		}
+	}
+
+	if key != "" && credentialKeyPattern.MatchString(key) && !isSetting(key) && !isReference(key, value) && couldBeCredential(value) {
+		return "variable name suggests a credential", true
+	}
+
+	if looksRandom(value) {
+		return "value looks like a random token", true
+	}
+
+	return "", false
+}
+
+// settingSuffixes end variable names that configure how credentials are
+// handled rather than hold one, e.g. TOKEN_TTL or PASSWORD_MIN_LENGTH
+var settingSuffixes = []string{"_TTL", "_LENGTH", "_LEN", "_EXPIRY", "_EXPIRES_IN", "_TIMEOUT", "_TYPE", "_NAME", "_HEADER", "_PATH", "_ENABLED"}
+
+func isSetting(key string) bool {
+	key = strings.ToUpper(key)
+	for _, suffix := range settingSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Thresholds for values flagged on their variable name alone
+const (
+	minKeyedLength  = 6
+	minKeyedEntropy = 2.0
+)
+
+// couldBeCredential reports whether a value named like a credential could
+// be one: long enough, not a number or boolean, and not a repeated
+// character
+func couldBeCredential(value string) bool {
+	if len(value) < minKeyedLength {
+		return false
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return false
+	}
+	if _, err := strconv.ParseBool(value); err == nil {
+		return false
+	}
+	return Entropy(value) >= minKeyedEntropy
+}
+
+// Thresholds for values that look like generated tokens
+const (
+	minTokenLength  = 24
+	minTokenEntropy = 4.0
+)
+
+// tokenPattern matches single words made of the characters of base64, hex
+// and URL-safe tokens
+var tokenPattern = regexp.MustCompile(`^[A-Za-z0-9+/=_-]+$`)
+
+// looksRandom reports whether value is a long single word with the entropy
+// of a generated token, mixing letters and digits
+func looksRandom(value string) bool {
+	if len(value) < minTokenLength || !tokenPattern.MatchString(value) {
+		return false
+	}
+	if !strings.ContainsAny(value, "0123456789") || strings.IndexFunc(value, isLetter) < 0 {
+		return false
+	}
+	// Hex checksums stay below the threshold, at most 4 bits per character
+	return Entropy(value) >= minTokenEntropy
+}
+
+func isLetter(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+// Entropy returns the Shannon entropy of value in bits per character
+func Entropy(value string) float64 {
+	if value == "" {
+		return 0
+	}
+
+	counts := map[rune]int{}
+	for _, r := range value {
+		counts[r]++
+	}
+
+	length := float64(len([]rune(value)))
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / length
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// isReference reports whether a variable points at a credential instead of
+// containing one, e.g. DB_PASSWORD_FILE=/run/secrets/db
+func isReference(key, value string) bool {
+	return strings.HasSuffix(strings.ToUpper(key), "_FILE") ||
+		strings.HasPrefix(value, "/run/secrets/") ||
+		strings.HasPrefix(value, "${")
+}
+
+// Mask hides all but the first few characters of a secret value
+func Mask(value string) string {
+	const visible = 3
+
+	if len(value) <= visible*2 {
+		return strings.Repeat("*", len(value))
+	}
+
+	return value[:visible] + strings.Repeat("*", min(len(value)-visible, 12))
+}
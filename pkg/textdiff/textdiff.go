@@ -0,0 +1,210 @@
+// Package textdiff computes line-based unified diffs.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// DefaultContext is the number of unchanged lines shown around a change
+const DefaultContext = 3
+
+// OpKind says whether a line was kept, removed or added
+type OpKind int
+
+const (
+	Equal OpKind = iota
+	Delete
+	Insert
+)
+
+// Op is one line of a diff
+type Op struct {
+	Kind OpKind
+	Line string
+}
+
+// Hunk is a group of changes with surrounding context
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Ops                []Op
+}
+
+// Header returns the @@ line of the hunk
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%s +%s @@", hunkRange(h.OldStart, h.OldLines), hunkRange(h.NewStart, h.NewLines))
+}
+
+func hunkRange(start, lines int) string {
+	if lines == 0 {
+		// An empty range points at the line before it
+		return fmt.Sprintf("%d,0", start-1)
+	}
+	if lines == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, lines)
+}
+
+// Lines diffs two texts line by line
+func Lines(a, b string) []Op {
+	return diff(splitLines(a), splitLines(b))
+}
+
+// Hunks groups the changes between two texts into hunks with context lines
+// of unchanged text around them. It returns nil when the texts are equal.
+func Hunks(a, b string, context int) []Hunk {
+	ops := Lines(a, b)
+
+	// Line numbers in the old and new text at which each op starts
+	oldLine := make([]int, len(ops)+1)
+	newLine := make([]int, len(ops)+1)
+	oldLine[0], newLine[0] = 1, 1
+	for i, op := range ops {
+		oldLine[i+1], newLine[i+1] = oldLine[i], newLine[i]
+		if op.Kind != Insert {
+			oldLine[i+1]++
+		}
+		if op.Kind != Delete {
+			newLine[i+1]++
+		}
+	}
+
+	var hunks []Hunk
+	for i := 0; i < len(ops); {
+		if ops[i].Kind == Equal {
+			i++
+			continue
+		}
+
+		// Extend the hunk while the next change is close enough to share context
+		last := i
+		for j := i + 1; j < len(ops) && j-last <= 2*context+1; j++ {
+			if ops[j].Kind != Equal {
+				last = j
+			}
+		}
+
+		start := max(i-context, 0)
+		end := min(last+context+1, len(ops))
+		hunks = append(hunks, Hunk{
+			OldStart: oldLine[start],
+			OldLines: oldLine[end] - oldLine[start],
+			NewStart: newLine[start],
+			NewLines: newLine[end] - newLine[start],
+			Ops:      ops[start:end],
+		})
+		i = end
+	}
+
+	return hunks
+}
+
+// Unified returns a unified diff between two texts, or an empty string when
+// they are equal
+func Unified(oldName, newName, a, b string, context int) string {
+	hunks := Hunks(a, b, context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+	for _, hunk := range hunks {
+		sb.WriteString(hunk.Header())
+		sb.WriteString("\n")
+		for _, op := range hunk.Ops {
+			sb.WriteString(prefix(op.Kind))
+			sb.WriteString(op.Line)
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// Colorize colors the lines of a unified diff for the terminal
+func Colorize(diff string) string {
+	var sb strings.Builder
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "---"), strings.HasPrefix(line, "+++"):
+			line = color.New(color.Bold).Sprint(line)
+		case strings.HasPrefix(line, "@@"):
+			line = color.CyanString(line)
+		case strings.HasPrefix(line, "-"):
+			line = color.RedString(line)
+		case strings.HasPrefix(line, "+"):
+			line = color.GreenString(line)
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func prefix(kind OpKind) string {
+	switch kind {
+	case Delete:
+		return "-"
+	case Insert:
+		return "+"
+	default:
+		return " "
+	}
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diff computes the longest common subsequence of two line slices and
+// returns the edit script turning a into b
+func diff(a, b []string) []Op {
+	n, m := len(a), len(b)
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]Op, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, Op{Kind: Equal, Line: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, Op{Kind: Delete, Line: a[i]})
+			i++
+		default:
+			ops = append(ops, Op{Kind: Insert, Line: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, Op{Kind: Delete, Line: a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, Op{Kind: Insert, Line: b[j]})
+	}
+
+	return ops
+}
@@ -0,0 +1,137 @@
+// Package yamledit edits YAML documents through yaml.v3 nodes and writes
+// them back keeping the comments, key order, indentation and blank lines of
+// the original file.
+package yamledit
+
+import (
+	"bytes"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Encode writes root, parsed from original, with the indentation of original
+// and the blank lines yaml.v3 drops put back
+func Encode(root *yaml.Node, original []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(detectIndent(original))
+	if err := encoder.Encode(root); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return restoreBlankLines(original, buf.Bytes()), nil
+}
+
+// MappingValue returns the value of key in a mapping node
+func MappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// RemoveKey deletes key from a mapping node, reporting whether it was there
+func RemoveKey(node *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// SetKey sets key in a mapping node, appending it when missing
+func SetKey(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+}
+
+// restoreBlankLines puts back the blank lines yaml.v3 drops, before the keys
+// that were preceded by one in the original file. A key starts at the head
+// comment above it, so the blank line goes before the comment.
+func restoreBlankLines(original, fixed []byte) []byte {
+	var before, after yaml.Node
+	if yaml.Unmarshal(original, &before) != nil || yaml.Unmarshal(fixed, &after) != nil {
+		return fixed
+	}
+
+	originalLines := strings.Split(string(original), "\n")
+	blank := map[string]bool{}
+	for path, line := range keyLines(&before) {
+		if line >= 2 && strings.TrimSpace(originalLines[line-2]) == "" {
+			blank[path] = true
+		}
+	}
+
+	insert := map[int]bool{}
+	for path, line := range keyLines(&after) {
+		if blank[path] {
+			insert[line] = true
+		}
+	}
+
+	lines := strings.Split(string(fixed), "\n")
+	restored := make([]string, 0, len(lines)+len(insert))
+	for i, line := range lines {
+		if insert[i+1] {
+			restored = append(restored, "")
+		}
+		restored = append(restored, line)
+	}
+	return []byte(strings.Join(restored, "\n"))
+}
+
+// keyLines returns the first line of every mapping key, including its head
+// comment, by its dotted path
+func keyLines(root *yaml.Node) map[string]int {
+	lines := map[string]int{}
+	var visit func(node *yaml.Node, path string)
+	visit = func(node *yaml.Node, path string) {
+		switch node.Kind {
+		case yaml.DocumentNode:
+			for _, child := range node.Content {
+				visit(child, path)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key := path + "." + node.Content[i].Value
+				lines[key] = node.Content[i].Line
+				if comment := node.Content[i].HeadComment; comment != "" {
+					lines[key] -= strings.Count(comment, "\n") + 1
+				}
+				visit(node.Content[i+1], key)
+			}
+		}
+	}
+	visit(root, "")
+	return lines
+}
+
+// detectIndent returns the indentation of the first indented line, 2 when
+// the file has none
+func detectIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if indent := len(line) - len(trimmed); indent > 0 {
+			return indent
+		}
+	}
+	return 2
+}
@@ -0,0 +1,72 @@
+package yamledit
+
+import "testing"
+
+func TestRestoreBlankLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		original string
+		fixed    string
+		want     string
+	}{
+		{
+			name:     "between services",
+			original: "services:\n  web:\n    image: a\n\n  db:\n    image: b\n",
+			fixed:    "services:\n  web:\n    image: a\n  db:\n    image: b\n",
+			want:     "services:\n  web:\n    image: a\n\n  db:\n    image: b\n",
+		},
+		{
+			name:     "before a comment",
+			original: "services:\n  web:\n    image: a\n\n  # Database\n  # Postgres 16\n  db:\n    image: b\n",
+			fixed:    "services:\n  web:\n    image: a\n  # Database\n  # Postgres 16\n  db:\n    image: b\n",
+			want:     "services:\n  web:\n    image: a\n\n  # Database\n  # Postgres 16\n  db:\n    image: b\n",
+		},
+		{
+			name:     "removed key",
+			original: "services:\n  web:\n    image: a\n\n    privileged: true\n\n    restart: always\n",
+			fixed:    "services:\n  web:\n    image: a\n    restart: always\n",
+			want:     "services:\n  web:\n    image: a\n\n    restart: always\n",
+		},
+		{
+			name:     "added key",
+			original: "services:\n  web:\n    image: a\n\nvolumes: {}\n",
+			fixed:    "services:\n  web:\n    image: a\n    expose:\n      - \"80\"\nvolumes: {}\n",
+			want:     "services:\n  web:\n    image: a\n    expose:\n      - \"80\"\n\nvolumes: {}\n",
+		},
+		{
+			name:     "no blank lines",
+			original: "services:\n  web:\n    image: a\n",
+			fixed:    "services:\n  web:\n    image: b\n",
+			want:     "services:\n  web:\n    image: b\n",
+		},
+		{
+			name:     "invalid original",
+			original: "services: [\n",
+			fixed:    "services: {}\n",
+			want:     "services: {}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(restoreBlankLines([]byte(tt.original), []byte(tt.fixed))); got != tt.want {
+				t.Errorf("restoreBlankLines =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectIndent(t *testing.T) {
+	tests := map[string]int{
+		"services:\n  web:\n    image: a\n":                           2,
+		"services:\n    web:\n        image: a\n":                     4,
+		"# comment\n\n   # indented comment\nservices:\n   web: {}\n": 3,
+		"services: {}\n": 2,
+		"":               2,
+	}
+	for data, want := range tests {
+		if got := detectIndent([]byte(data)); got != want {
+			t.Errorf("detectIndent(%q) = %d, want %d", data, got, want)
+		}
+	}
+}